@@ -0,0 +1,2242 @@
+// Code generated by swaggo/swag. DO NOT EDIT.
+
+package docs
+
+import "github.com/swaggo/swag"
+
+const docTemplate = `{
+    "schemes": {{ marshal .Schemes }},
+    "swagger": "2.0",
+    "info": {
+        "description": "{{escape .Description}}",
+        "title": "{{.Title}}",
+        "contact": {},
+        "version": "{{.Version}}"
+    },
+    "host": "{{.Host}}",
+    "basePath": "{{.BasePath}}",
+    "paths": {
+        "/v1/intervals": {
+            "post": {
+                "security": [
+                    {
+                        "APIKey": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pricing"
+                ],
+                "summary": "Интервалы доставки",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Флаг КГТ",
+                        "name": "oversized",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "time_interval",
+                            "self_pickup"
+                        ],
+                        "type": "string",
+                        "default": "time_interval",
+                        "description": "Способ доставки",
+                        "name": "policy",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Параметры посылки",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/delivery.DeliveryIntervalsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/delivery.DeliveryIntervalsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.response"
+                        }
+                    },
+                    "502": {
+                        "description": "Bad Gateway",
+                        "schema": {
+                            "$ref": "#/definitions/main.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/locations": {
+            "get": {
+                "security": [
+                    {
+                        "APIKey": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "points"
+                ],
+                "summary": "Определение идентификатора населенного пункта",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Адрес",
+                        "name": "address",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/delivery.LocationIDResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.response"
+                        }
+                    },
+                    "502": {
+                        "description": "Bad Gateway",
+                        "schema": {
+                            "$ref": "#/definitions/main.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/offers": {
+            "post": {
+                "security": [
+                    {
+                        "APIKey": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "offers"
+                ],
+                "summary": "Создание офферов",
+                "parameters": [
+                    {
+                        "description": "Заказ",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/delivery.CreateOfferRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/delivery.CreateOfferResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.response"
+                        }
+                    },
+                    "502": {
+                        "description": "Bad Gateway",
+                        "schema": {
+                            "$ref": "#/definitions/main.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/offers/{offer_id}/confirm": {
+            "post": {
+                "security": [
+                    {
+                        "APIKey": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "offers"
+                ],
+                "summary": "Подтверждение оффера",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор оффера",
+                        "name": "offer_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/delivery.ConfirmOfferResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.response"
+                        }
+                    },
+                    "502": {
+                        "description": "Bad Gateway",
+                        "schema": {
+                            "$ref": "#/definitions/main.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/points": {
+            "post": {
+                "security": [
+                    {
+                        "APIKey": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "points"
+                ],
+                "summary": "Список пунктов выдачи",
+                "parameters": [
+                    {
+                        "description": "Фильтр",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/delivery.DeliveryPointsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/delivery.DeliveryPointsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.response"
+                        }
+                    },
+                    "502": {
+                        "description": "Bad Gateway",
+                        "schema": {
+                            "$ref": "#/definitions/main.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/price": {
+            "post": {
+                "security": [
+                    {
+                        "APIKey": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pricing"
+                ],
+                "summary": "Предварительная оценка стоимости доставки",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Флаг КГТ",
+                        "name": "oversized",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Параметры посылки",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/delivery.PredictPriceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/delivery.PredictPriceResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.response"
+                        }
+                    },
+                    "502": {
+                        "description": "Bad Gateway",
+                        "schema": {
+                            "$ref": "#/definitions/main.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/requests": {
+            "post": {
+                "security": [
+                    {
+                        "APIKey": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "requests"
+                ],
+                "summary": "Создание заказа",
+                "parameters": [
+                    {
+                        "description": "Заказ",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/delivery.CreateRequestRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/delivery.CreateRequestResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.response"
+                        }
+                    },
+                    "502": {
+                        "description": "Bad Gateway",
+                        "schema": {
+                            "$ref": "#/definitions/main.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/requests/{request_id}": {
+            "get": {
+                "security": [
+                    {
+                        "APIKey": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "requests"
+                ],
+                "summary": "Информация о заказе",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор заказа",
+                        "name": "request_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Краткая информация",
+                        "name": "slim",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/delivery.GetRequestInfoResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.response"
+                        }
+                    },
+                    "502": {
+                        "description": "Bad Gateway",
+                        "schema": {
+                            "$ref": "#/definitions/main.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/requests/{request_id}/actual": {
+            "get": {
+                "security": [
+                    {
+                        "APIKey": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "requests"
+                ],
+                "summary": "Актуальная информация о доставке",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор заказа",
+                        "name": "request_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/delivery.GetRequestActualInfoResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.response"
+                        }
+                    },
+                    "502": {
+                        "description": "Bad Gateway",
+                        "schema": {
+                            "$ref": "#/definitions/main.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/requests/{request_id}/cancel": {
+            "post": {
+                "security": [
+                    {
+                        "APIKey": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "requests"
+                ],
+                "summary": "Отмена заказа",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор заказа",
+                        "name": "request_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Причина отмены",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/main.cancelRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/delivery.CancelRequestResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.response"
+                        }
+                    },
+                    "502": {
+                        "description": "Bad Gateway",
+                        "schema": {
+                            "$ref": "#/definitions/main.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/requests/{request_id}/edit": {
+            "post": {
+                "security": [
+                    {
+                        "APIKey": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "requests"
+                ],
+                "summary": "Редактирование заказа",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор заказа",
+                        "name": "request_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Изменения",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/delivery.EditRequestInfoRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/delivery.EditRequestInfoResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.response"
+                        }
+                    },
+                    "502": {
+                        "description": "Bad Gateway",
+                        "schema": {
+                            "$ref": "#/definitions/main.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/requests/{request_id}/history": {
+            "get": {
+                "security": [
+                    {
+                        "APIKey": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "requests"
+                ],
+                "summary": "История статусов заказа",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор заказа",
+                        "name": "request_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/main.response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/delivery.GetRequestHistoryResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.response"
+                        }
+                    },
+                    "502": {
+                        "description": "Bad Gateway",
+                        "schema": {
+                            "$ref": "#/definitions/main.response"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "delivery.Address": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "description": "Комментарий",
+                    "type": "string"
+                },
+                "full_address": {
+                    "description": "Полный адрес с указанием города, улицы и номера дома.\nНомер квартиры, подъезда и этаж указывать не нужно",
+                    "type": "string"
+                },
+                "room": {
+                    "description": "Номер квартиры или офиса, обязателен при наличии",
+                    "type": "string"
+                }
+            }
+        },
+        "delivery.AvailableActions": {
+            "type": "object",
+            "properties": {
+                "update_address_available": {
+                    "type": "boolean"
+                },
+                "update_courier_to_pickup_available": {
+                    "type": "boolean"
+                },
+                "update_dates_available": {
+                    "type": "boolean"
+                },
+                "update_items": {
+                    "type": "boolean"
+                },
+                "update_pickup_to_courier_available": {
+                    "type": "boolean"
+                },
+                "update_pickup_to_pickup_available": {
+                    "type": "boolean"
+                },
+                "update_places": {
+                    "type": "boolean"
+                },
+                "update_recipient": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "delivery.BillingDetails": {
+            "type": "object",
+            "properties": {
+                "assessed_unit_price": {
+                    "description": "Оценочная цена за единицу товара (передается в копейках)",
+                    "type": "integer"
+                },
+                "inn": {
+                    "description": "ИНН",
+                    "type": "string"
+                },
+                "nds": {
+                    "description": "Значение НДС.\nДопустимые значения — 0, 5, 7, 10, 20.\nЕсли заказ без НДС, передавайте значение -1",
+                    "type": "integer"
+                },
+                "unit_price": {
+                    "description": "Цена за единицу товара (передается в копейках)",
+                    "type": "integer"
+                }
+            }
+        },
+        "delivery.BillingInfo": {
+            "type": "object",
+            "properties": {
+                "delivery_cost": {
+                    "description": "Сумма, которую нужно взять с получателя за доставку. Актуально только для заказов с постоплатой (типы оплаты cash_on_receipt и card_on_receipt)",
+                    "type": "integer"
+                },
+                "payment_method": {
+                    "description": "Метод оплаты",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.PaymentMethod"
+                        }
+                    ]
+                }
+            }
+        },
+        "delivery.CancelRequestResponse": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "description": "Комментарий к результату выполнения запроса",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "Статус отмены заявки. Может принимать только значения из enum.\nCREATED: отмена заявки инициирована в платформе\nSUCCESS: отмена заявки успешно выполнена\nERROR: запрос завершился с ошибкой",
+                    "type": "string"
+                }
+            }
+        },
+        "delivery.ConfirmOfferResponse": {
+            "type": "object",
+            "properties": {
+                "request_id": {
+                    "description": "Идентификатор только что созданного заказа",
+                    "type": "string"
+                }
+            }
+        },
+        "delivery.Contact": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "description": "Электронная почта",
+                    "type": "string"
+                },
+                "first_name": {
+                    "description": "Имя",
+                    "type": "string"
+                },
+                "last_name": {
+                    "description": "Фамилия",
+                    "type": "string"
+                },
+                "partonymic": {
+                    "description": "Отчество",
+                    "type": "string"
+                },
+                "phone": {
+                    "description": "Телефон",
+                    "type": "string"
+                }
+            }
+        },
+        "delivery.CoordinateInterval": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "description": "Нижняя граница интервала",
+                    "type": "integer"
+                },
+                "to": {
+                    "description": "Верхняя граница интервала",
+                    "type": "integer"
+                }
+            }
+        },
+        "delivery.CreateOfferRequest": {
+            "type": "object",
+            "properties": {
+                "available_actions": {
+                    "$ref": "#/definitions/delivery.AvailableActions"
+                },
+                "billing_info": {
+                    "description": "Данные для биллинга",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.BillingInfo"
+                        }
+                    ]
+                },
+                "destination": {
+                    "description": "Информация о точке получения заказа",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.Destination"
+                        }
+                    ]
+                },
+                "info": {
+                    "description": "Базовый набор метаданных по запросу",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.Info"
+                        }
+                    ]
+                },
+                "items": {
+                    "description": "Информация о предметах в заказе",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/delivery.Item"
+                    }
+                },
+                "last_mile_policy": {
+                    "description": "Требуемый способ доставки",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.LastMilePolicy"
+                        }
+                    ]
+                },
+                "particular_items_refuse": {
+                    "description": "Разрешен ли частичный выкуп\ntrue — разрешен частичный выкуп заказа\nfalse — частичный выкуп заказа недоступен\nЗначение по умолчанию: false",
+                    "type": "boolean"
+                },
+                "places": {
+                    "description": "Информация о местах в заказе",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/delivery.Place"
+                    }
+                },
+                "recipient_info": {
+                    "description": "Данные о получателе",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.Contact"
+                        }
+                    ]
+                },
+                "source": {
+                    "description": "Информация о точке отправления заказа",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.Source"
+                        }
+                    ]
+                }
+            }
+        },
+        "delivery.CreateOfferResponse": {
+            "type": "object",
+            "properties": {
+                "offers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/delivery.OfferItem"
+                    }
+                }
+            }
+        },
+        "delivery.CreateRequestRequest": {
+            "type": "object",
+            "properties": {
+                "available_actions": {
+                    "$ref": "#/definitions/delivery.AvailableActions"
+                },
+                "billing_info": {
+                    "description": "Данные для биллинга",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.BillingInfo"
+                        }
+                    ]
+                },
+                "destination": {
+                    "description": "Информация о точке получения заказа",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.Destination"
+                        }
+                    ]
+                },
+                "info": {
+                    "description": "Базовый набор метаданных по запросу",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.Info"
+                        }
+                    ]
+                },
+                "items": {
+                    "description": "Информация о предметах в заказе",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/delivery.Item"
+                    }
+                },
+                "last_mile_policy": {
+                    "description": "Требуемый способ доставки",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.LastMilePolicy"
+                        }
+                    ]
+                },
+                "particular_items_refuse": {
+                    "description": "Разрешен ли частичный выкуп\ntrue — разрешен частичный выкуп заказа\nfalse — частичный выкуп заказа недоступен\nЗначение по умолчанию: false",
+                    "type": "boolean"
+                },
+                "places": {
+                    "description": "Информация о местах в заказе",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/delivery.Place"
+                    }
+                },
+                "recipient_info": {
+                    "description": "Данные о получателе",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.Contact"
+                        }
+                    ]
+                },
+                "source": {
+                    "description": "Информация о точке отправления заказа",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.Source"
+                        }
+                    ]
+                }
+            }
+        },
+        "delivery.CreateRequestResponse": {
+            "type": "object",
+            "properties": {
+                "request_id": {
+                    "description": "Идентификатор только что созданного заказа",
+                    "type": "string"
+                }
+            }
+        },
+        "delivery.CustomLocation": {
+            "type": "object",
+            "properties": {
+                "details": {
+                    "description": "Детали",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.Address"
+                        }
+                    ]
+                },
+                "latitude": {
+                    "description": "Широта",
+                    "type": "number"
+                },
+                "longitude": {
+                    "description": "Долгота",
+                    "type": "number"
+                }
+            }
+        },
+        "delivery.Dayoff": {
+            "type": "object",
+            "properties": {
+                "date_utc": {
+                    "description": "Дата в формате UTC",
+                    "type": "string"
+                }
+            }
+        },
+        "delivery.DeliveryInterval": {
+            "type": "object",
+            "properties": {
+                "max": {
+                    "description": "Верхняя граница временного интервала доставки, UTC Timestamp в Гринвиче.",
+                    "type": "string"
+                },
+                "min": {
+                    "description": "Нижняя граница временного интервала доставки, UTC Timestamp в Гринвиче.",
+                    "type": "string"
+                },
+                "policy": {
+                    "description": "Политика доставки последней мили в предложенном варианте.",
+                    "type": "string"
+                }
+            }
+        },
+        "delivery.DeliveryIntervalsRequest": {
+            "type": "object",
+            "properties": {
+                "destination": {
+                    "$ref": "#/definitions/delivery.Destination"
+                },
+                "places": {
+                    "description": "Информация о местах в заказе",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/delivery.Place"
+                    }
+                },
+                "source": {
+                    "$ref": "#/definitions/delivery.Source"
+                }
+            }
+        },
+        "delivery.DeliveryIntervalsResponse": {
+            "type": "object",
+            "properties": {
+                "offers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/delivery.Offer"
+                    }
+                }
+            }
+        },
+        "delivery.DeliveryPointsRequest": {
+            "type": "object",
+            "properties": {
+                "available_for_dropoff": {
+                    "description": "Возможность отгрузки заказов в точку (самопривоз).",
+                    "type": "boolean"
+                },
+                "geo_id": {
+                    "description": "Идентификатор населенного пункта (geo_id)",
+                    "type": "integer"
+                },
+                "is_not_branded_partner_station": {
+                    "description": "Признак добавляющий партнерские ПВЗ.",
+                    "type": "boolean"
+                },
+                "is_post_office": {
+                    "description": "Признак добавляющий ПВЗ почты россии.",
+                    "type": "boolean"
+                },
+                "is_yandex_branded": {
+                    "description": "Признак брендированные ли ПВЗ.",
+                    "type": "boolean"
+                },
+                "latitude": {
+                    "description": "Интервал для выбора всех объектов в отрезке по широте.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.CoordinateInterval"
+                        }
+                    ]
+                },
+                "longitude": {
+                    "description": "Интервал для выбора всех объектов в отрезке по долготе.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.CoordinateInterval"
+                        }
+                    ]
+                },
+                "payment_method": {
+                    "description": "Тип оплаты в точке самостоятельного получения заказа.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.PaymentMethod"
+                        }
+                    ]
+                },
+                "payment_methods": {
+                    "description": "Набор типов оплаты, которы должны быть доступны в самостоятельного точке получения заказа.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/delivery.PaymentMethod"
+                    }
+                },
+                "pickup_point_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "type": {
+                    "description": "Тип точки приема/выдачи заказа.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.PickupStationType"
+                        }
+                    ]
+                }
+            }
+        },
+        "delivery.DeliveryPointsResponse": {
+            "type": "object",
+            "properties": {
+                "points": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/delivery.Point"
+                    }
+                }
+            }
+        },
+        "delivery.Destination": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "description": "Адрес получения с указанием города, улицы и номера дома.\nНомер квартиры, подъезда и этаж указывать не нужно",
+                    "type": "string"
+                },
+                "custom_location": {
+                    "description": "Полное описание целевого адреса доставки",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.CustomLocation"
+                        }
+                    ]
+                },
+                "interval_utc": {
+                    "description": "Временной интервал (в UNIX)",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.IntervalUTC"
+                        }
+                    ]
+                },
+                "platform_station": {
+                    "description": "Описание целевой станции в случае, если она зарегистрирована в платформе",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.PlatformStation"
+                        }
+                    ]
+                },
+                "platform_station_id": {
+                    "description": "ID ПВЗ или постамата, зарегистрированного в платформе, в который нужна доставка",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "Тип целевой точки.\nДля доставки до двери — custom_location (2),\nдля доставки до ПВЗ — platform_station (1)",
+                    "type": "string"
+                }
+            }
+        },
+        "delivery.EditRequestInfoRequest": {
+            "type": "object",
+            "properties": {
+                "destination": {
+                    "description": "Информация о пункте назначения",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.Destination"
+                        }
+                    ]
+                },
+                "last_mile_policy": {
+                    "description": "Политика доставки",
+                    "type": "string"
+                },
+                "places": {
+                    "description": "Данные о грузоместах",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/delivery.PlaceElement"
+                    }
+                },
+                "recipient_info": {
+                    "description": "Информация о получателе",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.Contact"
+                        }
+                    ]
+                },
+                "request_id": {
+                    "description": "ID заказа",
+                    "type": "string"
+                }
+            }
+        },
+        "delivery.EditRequestInfoResponse": {
+            "type": "object",
+            "properties": {
+                "active_updates": {
+                    "description": "Выполняющиеся изменения заказа",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/delivery.Update"
+                    }
+                },
+                "completed_updates": {
+                    "description": "Выполненные изменения заказа",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/delivery.Update"
+                    }
+                },
+                "edit_id": {
+                    "description": "ID операции редактирования",
+                    "type": "string"
+                },
+                "ignored_updates": {
+                    "description": "Невыполненные изменения заказа",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/delivery.Update"
+                    }
+                }
+            }
+        },
+        "delivery.GetRequestActualInfoResponse": {
+            "type": "object",
+            "properties": {
+                "delivery_date": {
+                    "description": "Дата доставки",
+                    "type": "string"
+                },
+                "delivery_interval": {
+                    "description": "Интервал доставки",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.DeliveryInterval"
+                        }
+                    ]
+                }
+            }
+        },
+        "delivery.GetRequestHistoryResponse": {
+            "type": "object",
+            "properties": {
+                "state_history": {
+                    "description": "История изменения статусов заказа",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/delivery.StateHistory"
+                    }
+                }
+            }
+        },
+        "delivery.GetRequestInfoResponse": {
+            "type": "object",
+            "properties": {
+                "courier_order_id": {
+                    "description": "Номер заказа в системе оператора",
+                    "type": "string"
+                },
+                "full_items_price": {
+                    "description": "Общая стоимость всех предметов в заказе",
+                    "type": "integer"
+                },
+                "request": {
+                    "$ref": "#/definitions/delivery.RequestInfo"
+                },
+                "request_id": {
+                    "description": "ID заказа в логистической платформе",
+                    "type": "string"
+                },
+                "sharing_url": {
+                    "description": "Ссылка на страницу с трекингом заказа для получателя",
+                    "type": "string"
+                },
+                "state": {
+                    "description": "Текущий статус заказа",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.State"
+                        }
+                    ]
+                }
+            }
+        },
+        "delivery.Info": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "description": "Комментарий к заказу",
+                    "type": "string"
+                },
+                "operator_request_id": {
+                    "description": "Идентификатор заказа у отправителя",
+                    "type": "string"
+                }
+            }
+        },
+        "delivery.IntervalUTC": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "type": "string"
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
+        "delivery.Item": {
+            "type": "object",
+            "properties": {
+                "article": {
+                    "description": "Артикул товара",
+                    "type": "string"
+                },
+                "billing_details": {
+                    "description": "Детали оплаты товара",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.BillingDetails"
+                        }
+                    ]
+                },
+                "count": {
+                    "description": "Количество товара",
+                    "type": "integer"
+                },
+                "marking_code": {
+                    "description": "Код маркировки товара",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Название товара",
+                    "type": "string"
+                },
+                "physical_dims": {
+                    "description": "Физические размеры товара",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.PhysicalDims"
+                        }
+                    ]
+                },
+                "place_barcode": {
+                    "description": "Штрихкод места хранения товара",
+                    "type": "string"
+                },
+                "uin": {
+                    "description": "Уникальный идентификатор товара",
+                    "type": "string"
+                }
+            }
+        },
+        "delivery.LastMilePolicy": {
+            "type": "string",
+            "enum": [
+                "time_interval",
+                "self_pickup"
+            ],
+            "x-enum-varnames": [
+                "LMP_TimeInterval",
+                "LMP_SelfPickup"
+            ]
+        },
+        "delivery.LocationDetectedVariant": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "description": "Вариант адреса",
+                    "type": "string"
+                },
+                "geo_id": {
+                    "description": "Идентификатор населенного пункта (geo_id)",
+                    "type": "integer"
+                }
+            }
+        },
+        "delivery.LocationIDResponse": {
+            "type": "object",
+            "properties": {
+                "variants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/delivery.LocationDetectedVariant"
+                    }
+                }
+            }
+        },
+        "delivery.Offer": {
+            "type": "object",
+            "properties": {
+                "delivered_by_post": {
+                    "description": "Будет ли заказ доставляться почтой россии",
+                    "type": "boolean"
+                },
+                "from": {
+                    "description": "UNIX или UTC время предлагаемого начала доставки",
+                    "type": "string"
+                },
+                "to": {
+                    "description": "UNIX или UTC время предлагаемого окончания доставки",
+                    "type": "string"
+                }
+            }
+        },
+        "delivery.OfferDetails": {
+            "type": "object",
+            "properties": {
+                "delivery_interval": {
+                    "$ref": "#/definitions/delivery.DeliveryInterval"
+                },
+                "pickup_interval": {
+                    "$ref": "#/definitions/delivery.PickupInterval"
+                },
+                "pricing": {
+                    "type": "string"
+                },
+                "pricing_commission_on_delivery_payment": {
+                    "type": "string"
+                },
+                "pricing_commission_on_delivery_payment_amount": {
+                    "type": "string"
+                },
+                "pricing_total": {
+                    "type": "string"
+                }
+            }
+        },
+        "delivery.OfferItem": {
+            "type": "object",
+            "properties": {
+                "expires_at": {
+                    "description": "Timestamp окончания действия предложения маршрутного листа в Гринвиче.",
+                    "type": "string"
+                },
+                "offer_details": {
+                    "description": "Подробности оффера.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.OfferDetails"
+                        }
+                    ]
+                },
+                "offer_id": {
+                    "description": "Идентификатор предложения маршрутного листа (оффера).",
+                    "type": "string"
+                }
+            }
+        },
+        "delivery.PaymentMethod": {
+            "type": "string",
+            "enum": [
+                "already_paid",
+                "card_on_receipt",
+                "cash_on_delivery"
+            ],
+            "x-enum-varnames": [
+                "PM_AlreadyPaid",
+                "PM_CardOnReceiot",
+                "PM_CashOnDelivery"
+            ]
+        },
+        "delivery.PhysicalDims": {
+            "type": "object",
+            "properties": {
+                "dx": {
+                    "description": "Длина, сантиметры",
+                    "type": "number"
+                },
+                "dy": {
+                    "description": "Высота, сантиметры",
+                    "type": "number"
+                },
+                "dz": {
+                    "description": "Ширина, сантиметры",
+                    "type": "number"
+                },
+                "predefined_volume": {
+                    "description": "Объем (в см3)",
+                    "type": "number"
+                },
+                "weight_gross": {
+                    "description": "Вес брутто, граммы",
+                    "type": "number"
+                }
+            }
+        },
+        "delivery.PickupInterval": {
+            "type": "object",
+            "properties": {
+                "max": {
+                    "description": "Верхняя граница временного интервала забора, UTC Timestamp в Гринвиче.",
+                    "type": "string"
+                },
+                "min": {
+                    "description": "Нижняя граница временного интервала забора, UTC Timestamp в Гринвиче.",
+                    "type": "string"
+                }
+            }
+        },
+        "delivery.PickupStationType": {
+            "type": "string",
+            "enum": [
+                "pickup_point",
+                "terminal",
+                "post_office",
+                "sorting_center"
+            ],
+            "x-enum-varnames": [
+                "PST_PickupPoint",
+                "PST_Terminal",
+                "PST_PostOffice",
+                "PST_SortingCenter"
+            ]
+        },
+        "delivery.Place": {
+            "type": "object",
+            "properties": {
+                "barcode": {
+                    "description": "Текущий штрихкод грузоместа",
+                    "type": "string"
+                },
+                "description": {
+                    "description": "Описание грузоместа",
+                    "type": "string"
+                },
+                "physical_dims": {
+                    "description": "Физические параметры места",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.PhysicalDims"
+                        }
+                    ]
+                }
+            }
+        },
+        "delivery.PlaceElement": {
+            "type": "object",
+            "properties": {
+                "barcode": {
+                    "description": "Текущий штрихкод грузоместа",
+                    "type": "string"
+                },
+                "place": {
+                    "description": "Информация о грузоместе",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.Place"
+                        }
+                    ]
+                }
+            }
+        },
+        "delivery.PlatformStation": {
+            "type": "object",
+            "properties": {
+                "platform_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "delivery.Point": {
+            "type": "object",
+            "properties": {
+                "ID": {
+                    "description": "Идентификатор точки забора заказа.\nДолжен использоваться при получении вариантов доставки в качестве конечной точки",
+                    "type": "string"
+                },
+                "address": {
+                    "description": "Полный адрес точки забора заказа",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.Address"
+                        }
+                    ]
+                },
+                "contact": {
+                    "description": "Данные для связи с точкой забора заказа",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.Contact"
+                        }
+                    ]
+                },
+                "dayoffs": {
+                    "description": "Нерабочие дни ПВЗ",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/delivery.Dayoff"
+                    }
+                },
+                "instruction": {
+                    "description": "Дополнительные указания по тому, как добраться до точки получения заказа",
+                    "type": "string"
+                },
+                "is_dark_store": {
+                    "description": "Признак даркстора",
+                    "type": "boolean"
+                },
+                "is_market_partner": {
+                    "description": "Признак партнерского ПВЗ",
+                    "type": "boolean"
+                },
+                "is_post_office": {
+                    "description": "Признак Почты России",
+                    "type": "boolean"
+                },
+                "is_yandex_branded": {
+                    "description": "Признак брендированные ли ПВЗ",
+                    "type": "boolean"
+                },
+                "name": {
+                    "description": "Название точки забора заказа",
+                    "type": "string"
+                },
+                "operator_station_id": {
+                    "description": "Идентификатор точки забора заказа в системе оператора",
+                    "type": "string"
+                },
+                "payment_methods": {
+                    "description": "Возможные методы оплаты заказа при получении",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "position": {
+                    "description": "Точка, в которой находится точка забора заказа",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.Position"
+                        }
+                    ]
+                },
+                "schedule": {
+                    "description": "Расписание работы точки",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.Schedule"
+                        }
+                    ]
+                },
+                "type": {
+                    "description": "Тип точки забора заказа",
+                    "type": "string"
+                }
+            }
+        },
+        "delivery.Position": {
+            "type": "object",
+            "properties": {
+                "latitude": {
+                    "description": "Широта",
+                    "type": "number"
+                },
+                "longitude": {
+                    "description": "Долгота",
+                    "type": "number"
+                }
+            }
+        },
+        "delivery.PredictPriceRequest": {
+            "type": "object",
+            "properties": {
+                "client_price": {
+                    "description": "Cумма к оплате с получателя в копейках",
+                    "type": "integer"
+                },
+                "destination": {
+                    "$ref": "#/definitions/delivery.Destination"
+                },
+                "payment_method": {
+                    "$ref": "#/definitions/delivery.PaymentMethod"
+                },
+                "places": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/delivery.Place"
+                    }
+                },
+                "source": {
+                    "$ref": "#/definitions/delivery.Source"
+                },
+                "tariff": {
+                    "description": "Тариф доставки",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.LastMilePolicy"
+                        }
+                    ]
+                },
+                "total_assessed_price": {
+                    "description": "Суммарная оценочная стоимость посылок в копейках",
+                    "type": "integer"
+                },
+                "total_weight": {
+                    "description": "Cуммарный вес посылки в граммах",
+                    "type": "integer"
+                }
+            }
+        },
+        "delivery.PredictPriceResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "boolean"
+                },
+                "pricing": {
+                    "description": "Стоимость за услугу доставки и страхование посылки в руб (с НДС).\nВ случае указания способа оплаты already_paid не заполняется",
+                    "type": "string"
+                },
+                "pricing_commission_on_delivery_payment": {
+                    "description": "Размер комисиии за прием наложенного платежа в %.\nЗаполняется в случае указания способа оплаты отлиного от already_paid",
+                    "type": "string"
+                },
+                "pricing_commission_on_delivery_payment_amount": {
+                    "description": "Размер комисиии за прием наложенного платежа в руб (с НДС).\nЗаполняется в случае указания способа оплаты отлиного от already_paid",
+                    "type": "string"
+                },
+                "pricing_total": {
+                    "description": "Суммарная стоимость доставки с учетом дополнительных услуг (с НДС)",
+                    "type": "string"
+                }
+            }
+        },
+        "delivery.Reason": {
+            "type": "string",
+            "enum": [
+                "SHOP_CANCELLED",
+                "USER_CHANGED_MIND",
+                "DELIVERY_PROBLEMS",
+                "DIMENSIONS_EXCEEDED",
+                "DIMENSIONS_EXCEEDED_LOCKER",
+                "NO_PASSPORT",
+                "ORDER_IS_DAMAGED",
+                "EXTRA_RESCHEDULING",
+                "BROKEN_ITEM",
+                "ORDER_ITEMS_QUANTITY_MISMATCH",
+                "ORDER_WAS_LOST",
+                "LATE_CONTACT",
+                "PICKUP_EXPIRED",
+                "CLIENT_REQUEST",
+                "COURIES_COULD_NOT_CONTACT_RECIPIENT",
+                "DELIVERY_DATE_UPDATED_BY_DELIVERY",
+                "DELIVERY_DATE_UPDATED_BY_RECIPIENT",
+                "DELIVERY_DATE_UPDATED_BY_SHOP",
+                "LAST_MILE_CHANGED_BY_USER",
+                "LOCKER_FULL",
+                "NO_PASSPORT",
+                "PICKUPPOINT_TECHNICAL_ISSUES",
+                "UNKNOWN",
+                "OTHER"
+            ],
+            "x-enum-comments": {
+                "R_Cancel_BrokenItem": "Товар оказался бракованным",
+                "R_Cancel_DeliveryProblems": "Проблемы с доставкой",
+                "R_Cancel_DimensionsExceeded": "Посылка слишком большая для способа доставки",
+                "R_Cancel_DimensionsExceededLocker": "Превышены допустимые габариты постамата",
+                "R_Cancel_ExtraRescheduling": "Заказ отменен из-за частых переносов",
+                "R_Cancel_LateContact": "С пользователем связались слишком поздно",
+                "R_Cancel_NoPassport": "Нет паспорта",
+                "R_Cancel_OrderIsDamaged": "Заказ поврежден",
+                "R_Cancel_OrderItemsQuantityMismatch": "Не совпадает количество товаров",
+                "R_Cancel_OrderWasLost": "Заказ утерян",
+                "R_Cancel_PickupExpired": "Срок хранения в пункте выдачи истек",
+                "R_Cancel_ShopCanceled": "Отправитель отменил заказ",
+                "R_Cancel_UserChangedMind": "Покупатель передумал",
+                "R_Change_ClientRequest": "\"По просьбе клиента\"",
+                "R_Change_CouriesCouldNotContactRecipient": "\"Курьер не смог дозвониться\"",
+                "R_Change_DeliveryDateUupdatedByDelivery": "\"Задержка обработки заказа партнёром\"",
+                "R_Change_DeliveryDateUupdatedByRecipient": "\"По запросу от пользователя\"",
+                "R_Change_DeliveryDateUupdatedByShop": "\"По запросу от магазина\"",
+                "R_Change_LastMileChangedByUser": "\"Последняя миля изменена по инициативе пользователя\"",
+                "R_Change_LockerFull": "\"Нет свободных ячеек подходящего размера\"",
+                "R_Change_NoPassport": "\"Нет паспорта\"",
+                "R_Change_PickupPointTechnicalIssues": "\"Технические проблемы в ПВЗ\"",
+                "R_Other": "\"Другая проблема\"",
+                "R_Unknown": "\"Не определён\""
+            },
+            "x-enum-varnames": [
+                "R_Cancel_ShopCanceled",
+                "R_Cancel_UserChangedMind",
+                "R_Cancel_DeliveryProblems",
+                "R_Cancel_DimensionsExceeded",
+                "R_Cancel_DimensionsExceededLocker",
+                "R_Cancel_NoPassport",
+                "R_Cancel_OrderIsDamaged",
+                "R_Cancel_ExtraRescheduling",
+                "R_Cancel_BrokenItem",
+                "R_Cancel_OrderItemsQuantityMismatch",
+                "R_Cancel_OrderWasLost",
+                "R_Cancel_LateContact",
+                "R_Cancel_PickupExpired",
+                "R_Change_ClientRequest",
+                "R_Change_CouriesCouldNotContactRecipient",
+                "R_Change_DeliveryDateUupdatedByDelivery",
+                "R_Change_DeliveryDateUupdatedByRecipient",
+                "R_Change_DeliveryDateUupdatedByShop",
+                "R_Change_LastMileChangedByUser",
+                "R_Change_LockerFull",
+                "R_Change_NoPassport",
+                "R_Change_PickupPointTechnicalIssues",
+                "R_Unknown",
+                "R_Other"
+            ]
+        },
+        "delivery.RequestInfo": {
+            "type": "object",
+            "properties": {
+                "available_actions": {
+                    "$ref": "#/definitions/delivery.AvailableActions"
+                },
+                "billing_info": {
+                    "description": "Данные для биллинга",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.BillingInfo"
+                        }
+                    ]
+                },
+                "destination": {
+                    "description": "Информация о точке получения заказа",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.Destination"
+                        }
+                    ]
+                },
+                "info": {
+                    "description": "Базовый набор метаданных по запросу",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.Info"
+                        }
+                    ]
+                },
+                "items": {
+                    "description": "Информация о предметах в заказе",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/delivery.Item"
+                    }
+                },
+                "last_mile_policy": {
+                    "description": "Требуемый способ доставки",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.LastMilePolicy"
+                        }
+                    ]
+                },
+                "particular_items_refuse": {
+                    "description": "Разрешен ли частичный выкуп\ntrue — разрешен частичный выкуп заказа\nfalse — частичный выкуп заказа недоступен\nЗначение по умолчанию: false",
+                    "type": "boolean"
+                },
+                "places": {
+                    "description": "Информация о местах в заказе",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/delivery.Place"
+                    }
+                },
+                "recipient_info": {
+                    "description": "Данные о получателе",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.Contact"
+                        }
+                    ]
+                },
+                "source": {
+                    "description": "Информация о точке отправления заказа",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.Source"
+                        }
+                    ]
+                }
+            }
+        },
+        "delivery.Restriction": {
+            "type": "object",
+            "properties": {
+                "days": {
+                    "description": "Номера дней недели, к которым применяется правило.\n1 - понедельник,\n2 - вторник,\n...,\n7 - воскресенье.",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "time_from": {
+                    "description": "Время начала работы.",
+                    "type": "string"
+                },
+                "time_to": {
+                    "description": "Время окончания работы.",
+                    "type": "string"
+                }
+            }
+        },
+        "delivery.Schedule": {
+            "type": "object",
+            "properties": {
+                "restrictions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/delivery.Restriction"
+                    }
+                },
+                "time_zone": {
+                    "type": "integer"
+                }
+            }
+        },
+        "delivery.Source": {
+            "type": "object",
+            "properties": {
+                "interval_utc": {
+                    "$ref": "#/definitions/delivery.IntervalUTC"
+                },
+                "platform_station": {
+                    "$ref": "#/definitions/delivery.PlatformStation"
+                },
+                "platform_station_id": {
+                    "description": "ID склада отправки, зарегистрированного в платформе",
+                    "type": "string"
+                }
+            }
+        },
+        "delivery.State": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "description": "Описание статуса",
+                    "type": "string"
+                },
+                "reason": {
+                    "$ref": "#/definitions/delivery.Reason"
+                },
+                "status": {
+                    "description": "Статус, описывающий текущее состояние заказа",
+                    "type": "string"
+                },
+                "timestamp_utc": {
+                    "description": "Временная метка в формате UTC",
+                    "type": "string"
+                }
+            }
+        },
+        "delivery.StateHistory": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "description": "Описание статуса",
+                    "type": "string"
+                },
+                "reason": {
+                    "description": "Причина изменения статуса",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.Reason"
+                        }
+                    ]
+                },
+                "status": {
+                    "description": "Статус, описывающий текущее состояние заказа",
+                    "type": "string"
+                },
+                "timestamp_utc": {
+                    "description": "Временная метка в формате UTC",
+                    "type": "string"
+                }
+            }
+        },
+        "delivery.Update": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "description": "Кол статуса",
+                    "type": "string"
+                },
+                "error_details": {
+                    "description": "Описание ошибок",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "reason": {
+                    "description": "Причина формирования статуса",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "Статус обновления",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.UpdateStatus"
+                        }
+                    ]
+                },
+                "type": {
+                    "description": "Тип изменения",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.UpdateType"
+                        }
+                    ]
+                }
+            }
+        },
+        "delivery.UpdateStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "execution",
+                "success",
+                "failure"
+            ],
+            "x-enum-comments": {
+                "US_Execution": "Выполняется",
+                "US_Failure": "Не выполнено",
+                "US_Pending": "Ожидает выполнения",
+                "US_Success": "Выполнено"
+            },
+            "x-enum-varnames": [
+                "US_Pending",
+                "US_Execution",
+                "US_Success",
+                "US_Failure"
+            ]
+        },
+        "delivery.UpdateType": {
+            "type": "string",
+            "enum": [
+                "recipient_info",
+                "destination",
+                "delivery_dates",
+                "last_mile_policy",
+                "places"
+            ],
+            "x-enum-comments": {
+                "UT_DeliveryDates": "Интервал доставки",
+                "UT_Destination": "Место получения заказа",
+                "UT_LastMilePolicy": "Способ доставки",
+                "UT_Places": "Грузоместа",
+                "UT_RecipientInfo": "Данные получателя"
+            },
+            "x-enum-varnames": [
+                "UT_RecipientInfo",
+                "UT_Destination",
+                "UT_DeliveryDates",
+                "UT_LastMilePolicy",
+                "UT_Places"
+            ]
+        },
+        "main.cancelRequest": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "description": "Причина отмены",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.Reason"
+                        }
+                    ]
+                }
+            }
+        },
+        "main.response": {
+            "type": "object",
+            "properties": {
+                "data": {},
+                "message": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        }
+    },
+    "securityDefinitions": {
+        "APIKey": {
+            "type": "apiKey",
+            "name": "X-API-Key",
+            "in": "header"
+        }
+    }
+}`
+
+// SwaggerInfo holds exported Swagger Info so clients can modify it
+var SwaggerInfo = &swag.Spec{
+	Version:          "1.0",
+	Host:             "",
+	BasePath:         "/",
+	Schemes:          []string{},
+	Title:            "Yandex Delivery Gateway",
+	Description:      "Шлюз к API Яндекс Доставки для внутренних сервисов",
+	InfoInstanceName: "swagger",
+	SwaggerTemplate:  docTemplate,
+}
+
+func init() {
+	swag.Register(SwaggerInfo.InstanceName(), SwaggerInfo)
+}
@@ -0,0 +1,376 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/delivery"
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/utils"
+	"github.com/ReanSn0w/gokit/pkg/web"
+)
+
+var (
+	errInvalidAPIKey = errors.New("invalid api key")
+	errNoAddress     = errors.New("address is required")
+)
+
+// response формат ответа шлюза
+type response struct {
+	Success bool              `json:"success"`
+	Message map[string]string `json:"message,omitempty"`
+	Data    any               `json:"data,omitempty"`
+}
+
+// cancelRequest параметры отмены заказа
+type cancelRequest struct {
+	Reason delivery.Reason `json:"reason"` // Причина отмены
+}
+
+type gateway struct {
+	delivery delivery.DeliveryClient
+}
+
+func (g *gateway) routes() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v1/price", g.price)
+	mux.HandleFunc("POST /v1/intervals", g.intervals)
+	mux.HandleFunc("GET /v1/locations", g.locations)
+	mux.HandleFunc("POST /v1/points", g.points)
+	mux.HandleFunc("POST /v1/offers", g.createOffer)
+	mux.HandleFunc("POST /v1/offers/{offer_id}/confirm", g.confirmOffer)
+	mux.HandleFunc("POST /v1/requests", g.createRequest)
+	mux.HandleFunc("GET /v1/requests/{request_id}", g.requestInfo)
+	mux.HandleFunc("GET /v1/requests/{request_id}/history", g.requestHistory)
+	mux.HandleFunc("GET /v1/requests/{request_id}/actual", g.requestActualInfo)
+	mux.HandleFunc("POST /v1/requests/{request_id}/edit", g.editRequest)
+	mux.HandleFunc("POST /v1/requests/{request_id}/cancel", g.cancelRequest)
+	return mux
+}
+
+// price godoc
+//
+//	@Summary	Предварительная оценка стоимости доставки
+//	@Tags		pricing
+//	@Security	APIKey
+//	@Accept		json
+//	@Produce	json
+//	@Param		oversized	query		bool							false	"Флаг КГТ"
+//	@Param		request		body		delivery.PredictPriceRequest	true	"Параметры посылки"
+//	@Success	200			{object}	response{data=delivery.PredictPriceResponse}
+//	@Failure	400			{object}	response
+//	@Failure	502			{object}	response
+//	@Router		/v1/price [post]
+func (g *gateway) price(w http.ResponseWriter, r *http.Request) {
+	req := delivery.PredictPriceRequest{}
+	if !decode(w, r, &req) {
+		return
+	}
+
+	oversized, ok := queryBool(w, r, "oversized")
+	if !ok {
+		return
+	}
+
+	resp, err := g.client(r).GetPredictedPrice(oversized, req)
+	respond(w, resp, err)
+}
+
+// intervals godoc
+//
+//	@Summary	Интервалы доставки
+//	@Tags		pricing
+//	@Security	APIKey
+//	@Accept		json
+//	@Produce	json
+//	@Param		oversized	query		bool								false	"Флаг КГТ"
+//	@Param		policy		query		string								false	"Способ доставки"	Enums(time_interval, self_pickup)	default(time_interval)
+//	@Param		request		body		delivery.DeliveryIntervalsRequest	true	"Параметры посылки"
+//	@Success	200			{object}	response{data=delivery.DeliveryIntervalsResponse}
+//	@Failure	400			{object}	response
+//	@Failure	502			{object}	response
+//	@Router		/v1/intervals [post]
+func (g *gateway) intervals(w http.ResponseWriter, r *http.Request) {
+	req := delivery.DeliveryIntervalsRequest{}
+	if !decode(w, r, &req) {
+		return
+	}
+
+	policy := delivery.LastMilePolicy(r.URL.Query().Get("policy"))
+	if policy == "" {
+		policy = delivery.LMP_TimeInterval
+	}
+
+	oversized, ok := queryBool(w, r, "oversized")
+	if !ok {
+		return
+	}
+
+	resp, err := g.client(r).GetDeliveryIntervals(oversized, policy, req)
+	respond(w, resp, err)
+}
+
+// locations godoc
+//
+//	@Summary	Определение идентификатора населенного пункта
+//	@Tags		points
+//	@Security	APIKey
+//	@Produce	json
+//	@Param		address	query		string	true	"Адрес"
+//	@Success	200		{object}	response{data=delivery.LocationIDResponse}
+//	@Failure	400		{object}	response
+//	@Failure	502		{object}	response
+//	@Router		/v1/locations [get]
+func (g *gateway) locations(w http.ResponseWriter, r *http.Request) {
+	address := r.URL.Query().Get("address")
+	if address == "" {
+		web.NewResponse(errNoAddress).Write(http.StatusBadRequest, w)
+		return
+	}
+
+	resp, err := g.client(r).GetLocationID(address)
+	respond(w, resp, err)
+}
+
+// points godoc
+//
+//	@Summary	Список пунктов выдачи
+//	@Tags		points
+//	@Security	APIKey
+//	@Accept		json
+//	@Produce	json
+//	@Param		request	body		delivery.DeliveryPointsRequest	true	"Фильтр"
+//	@Success	200		{object}	response{data=delivery.DeliveryPointsResponse}
+//	@Failure	400		{object}	response
+//	@Failure	502		{object}	response
+//	@Router		/v1/points [post]
+func (g *gateway) points(w http.ResponseWriter, r *http.Request) {
+	req := delivery.DeliveryPointsRequest{}
+	if !decode(w, r, &req) {
+		return
+	}
+
+	resp, err := g.client(r).GetDeliveryPoints(req)
+	respond(w, resp, err)
+}
+
+// createOffer godoc
+//
+//	@Summary	Создание офферов
+//	@Tags		offers
+//	@Security	APIKey
+//	@Accept		json
+//	@Produce	json
+//	@Param		request	body		delivery.CreateOfferRequest	true	"Заказ"
+//	@Success	200		{object}	response{data=delivery.CreateOfferResponse}
+//	@Failure	400		{object}	response
+//	@Failure	502		{object}	response
+//	@Router		/v1/offers [post]
+func (g *gateway) createOffer(w http.ResponseWriter, r *http.Request) {
+	req := delivery.CreateOfferRequest{}
+	if !decode(w, r, &req) {
+		return
+	}
+
+	resp, err := g.client(r).CreateOffer(req)
+	respond(w, resp, err)
+}
+
+// confirmOffer godoc
+//
+//	@Summary	Подтверждение оффера
+//	@Tags		offers
+//	@Security	APIKey
+//	@Produce	json
+//	@Param		offer_id	path		string	true	"Идентификатор оффера"
+//	@Success	200			{object}	response{data=delivery.ConfirmOfferResponse}
+//	@Failure	400			{object}	response
+//	@Failure	502			{object}	response
+//	@Router		/v1/offers/{offer_id}/confirm [post]
+func (g *gateway) confirmOffer(w http.ResponseWriter, r *http.Request) {
+	resp, err := g.client(r).ConfirmOffer(r.PathValue("offer_id"))
+	respond(w, resp, err)
+}
+
+// createRequest godoc
+//
+//	@Summary	Создание заказа
+//	@Tags		requests
+//	@Security	APIKey
+//	@Accept		json
+//	@Produce	json
+//	@Param		request	body		delivery.CreateRequestRequest	true	"Заказ"
+//	@Success	200		{object}	response{data=delivery.CreateRequestResponse}
+//	@Failure	400		{object}	response
+//	@Failure	502		{object}	response
+//	@Router		/v1/requests [post]
+func (g *gateway) createRequest(w http.ResponseWriter, r *http.Request) {
+	req := delivery.CreateRequestRequest{}
+	if !decode(w, r, &req) {
+		return
+	}
+
+	resp, err := g.client(r).CreateRequest(req)
+	respond(w, resp, err)
+}
+
+// requestInfo godoc
+//
+//	@Summary	Информация о заказе
+//	@Tags		requests
+//	@Security	APIKey
+//	@Produce	json
+//	@Param		request_id	path		string	true	"Идентификатор заказа"
+//	@Param		slim		query		bool	false	"Краткая информация"
+//	@Success	200			{object}	response{data=delivery.GetRequestInfoResponse}
+//	@Failure	400			{object}	response
+//	@Failure	502			{object}	response
+//	@Router		/v1/requests/{request_id} [get]
+func (g *gateway) requestInfo(w http.ResponseWriter, r *http.Request) {
+	slim, ok := queryBool(w, r, "slim")
+	if !ok {
+		return
+	}
+
+	resp, err := g.client(r).GetRequestInfo(r.PathValue("request_id"), slim)
+	respond(w, resp, err)
+}
+
+// requestHistory godoc
+//
+//	@Summary	История статусов заказа
+//	@Tags		requests
+//	@Security	APIKey
+//	@Produce	json
+//	@Param		request_id	path		string	true	"Идентификатор заказа"
+//	@Success	200			{object}	response{data=delivery.GetRequestHistoryResponse}
+//	@Failure	400			{object}	response
+//	@Failure	502			{object}	response
+//	@Router		/v1/requests/{request_id}/history [get]
+func (g *gateway) requestHistory(w http.ResponseWriter, r *http.Request) {
+	resp, err := g.client(r).GetRequestHistory(r.PathValue("request_id"))
+	respond(w, resp, err)
+}
+
+// requestActualInfo godoc
+//
+//	@Summary	Актуальная информация о доставке
+//	@Tags		requests
+//	@Security	APIKey
+//	@Produce	json
+//	@Param		request_id	path		string	true	"Идентификатор заказа"
+//	@Success	200			{object}	response{data=delivery.GetRequestActualInfoResponse}
+//	@Failure	400			{object}	response
+//	@Failure	502			{object}	response
+//	@Router		/v1/requests/{request_id}/actual [get]
+func (g *gateway) requestActualInfo(w http.ResponseWriter, r *http.Request) {
+	resp, err := g.client(r).GetRequestActualInfo(r.PathValue("request_id"))
+	respond(w, resp, err)
+}
+
+// editRequest godoc
+//
+//	@Summary	Редактирование заказа
+//	@Tags		requests
+//	@Security	APIKey
+//	@Accept		json
+//	@Produce	json
+//	@Param		request_id	path		string							true	"Идентификатор заказа"
+//	@Param		request		body		delivery.EditRequestInfoRequest	true	"Изменения"
+//	@Success	200			{object}	response{data=delivery.EditRequestInfoResponse}
+//	@Failure	400			{object}	response
+//	@Failure	502			{object}	response
+//	@Router		/v1/requests/{request_id}/edit [post]
+func (g *gateway) editRequest(w http.ResponseWriter, r *http.Request) {
+	req := delivery.EditRequestInfoRequest{}
+	if !decode(w, r, &req) {
+		return
+	}
+
+	req.RequestID = r.PathValue("request_id")
+	resp, err := g.client(r).EditRequestInfo(req)
+	respond(w, resp, err)
+}
+
+// cancelRequest godoc
+//
+//	@Summary	Отмена заказа
+//	@Tags		requests
+//	@Security	APIKey
+//	@Accept		json
+//	@Produce	json
+//	@Param		request_id	path		string			true	"Идентификатор заказа"
+//	@Param		request		body		cancelRequest	false	"Причина отмены"
+//	@Success	200			{object}	response{data=delivery.CancelRequestResponse}
+//	@Failure	400			{object}	response
+//	@Failure	502			{object}	response
+//	@Router		/v1/requests/{request_id}/cancel [post]
+func (g *gateway) cancelRequest(w http.ResponseWriter, r *http.Request) {
+	req := cancelRequest{}
+	if r.ContentLength != 0 && !decode(w, r, &req) {
+		return
+	}
+
+	resp, err := g.client(r).CancelRequestWithReason(r.PathValue("request_id"), req.Reason)
+	respond(w, resp, err)
+}
+
+func (g *gateway) client(r *http.Request) delivery.DeliveryClient {
+	return g.delivery.WithContext(r.Context())
+}
+
+func decode(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		web.NewResponse(err).Write(http.StatusBadRequest, w)
+		return false
+	}
+
+	return true
+}
+
+func respond[T any](w http.ResponseWriter, data T, err error) {
+	if err != nil {
+		web.NewResponse(err).Write(errorStatus(err), w)
+		return
+	}
+
+	web.NewResponse(data).Write(http.StatusOK, w)
+}
+
+// errorStatus возвращает код ответа шлюза для ошибки API доставки.
+// Ошибки запроса (4xx) передаются вызывающему сервису, кроме ошибок
+// авторизации шлюза в API доставки, которые как и остальные ошибки возвращаются кодом 502
+func errorStatus(err error) int {
+	respErr := &utils.ResponseError{}
+	if !errors.As(err, &respErr) {
+		return http.StatusBadGateway
+	}
+
+	switch code := respErr.StatusCode; {
+	case code == http.StatusUnauthorized || code == http.StatusForbidden:
+		return http.StatusBadGateway
+	case code >= 400 && code < 500:
+		return code
+	default:
+		return http.StatusBadGateway
+	}
+}
+
+// queryBool разбирает логический параметр запроса.
+// Отсутствующий параметр равен false, некорректный завершает запрос кодом 400
+func queryBool(w http.ResponseWriter, r *http.Request, name string) (bool, bool) {
+	raw := r.URL.Query().Get(name)
+	if raw == "" {
+		return false, true
+	}
+
+	val, err := strconv.ParseBool(raw)
+	if err != nil {
+		web.NewResponse(fmt.Errorf("query parameter %v: %w", name, err)).Write(http.StatusBadRequest, w)
+		return false, false
+	}
+
+	return val, true
+}
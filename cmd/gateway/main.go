@@ -0,0 +1,112 @@
+//go:generate swag init -g main.go -d ./,../../pkg/delivery -o docs
+
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ReanSn0w/go-yandex-delivery/cmd/gateway/docs"
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/api"
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/utils"
+	"github.com/ReanSn0w/gokit/pkg/app"
+	"github.com/ReanSn0w/gokit/pkg/web"
+)
+
+var (
+	revision = "debug"
+
+	opts = struct {
+		app.Debug
+
+		Port    int           `long:"port" env:"PORT" default:"8080" description:"http server port"`
+		URL     string        `long:"url" env:"URL" default:"http://localhost:8080" description:"http server base url"`
+		Timeout time.Duration `long:"timeout" env:"TIMEOUT" default:"60s" description:"Yandex Delivery API timeout"`
+		Token   string        `long:"token" env:"TOKEN" description:"Yandex Delivery API token"`
+		Dev     bool          `long:"dev" env:"DEV" description:"use development environment"`
+		APIKeys []string      `long:"api-key" env:"API_KEYS" env-delim:"," description:"API keys of internal consumers"`
+	}{}
+)
+
+// @title						Yandex Delivery Gateway
+// @version					1.0
+// @description				Шлюз к API Яндекс Доставки для внутренних сервисов
+// @BasePath					/
+// @securityDefinitions.apikey	APIKey
+// @in							header
+// @name						X-API-Key
+func main() {
+	application := app.New("Yandex Delivery Gateway", revision, &opts)
+	log := application.Log()
+
+	if opts.Token == "" || len(opts.APIKeys) == 0 {
+		log.Logf("[ERROR] token and at least one api key are required")
+		return
+	}
+
+	env := utils.Production
+	if opts.Dev {
+		env = utils.Development
+	}
+
+	gw := &gateway{
+		delivery: api.New(env, &http.Client{Timeout: opts.Timeout}, opts.Token).Delivery(),
+	}
+
+	baseURL, err := url.Parse(opts.URL)
+	if err != nil {
+		log.Logf("[ERROR] parse base url: %v", err)
+		return
+	}
+	docs.SwaggerInfo.Host = baseURL.Host
+
+	mux := http.NewServeMux()
+	mux.Handle("/v1/", apiKeys(opts.APIKeys)(gw.routes()))
+	mux.Handle("/swagger/", web.SwaggerHandler(baseURL, "/swagger/doc.json"))
+	mux.HandleFunc("/", web.JSON_NotFoundHandlerFunc)
+
+	srv := web.New(log)
+	srv.Run(application.CancelCause(), opts.Port, web.Ping()(mux))
+	application.Add(func(ctx context.Context) { srv.Shutdown(ctx) })
+	application.GracefulShutdown(10 * time.Second)
+}
+
+// apiKeys проверяет ключ доступа внутреннего сервиса
+// в заголовке X-API-Key или Authorization: Bearer
+func apiKeys(keys []string) func(http.Handler) http.Handler {
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get("X-API-Key")
+			if key == "" {
+				key = strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+			}
+
+			if !validKey(keys, key) {
+				web.NewResponse(errInvalidAPIKey).Write(http.StatusUnauthorized, w)
+				return
+			}
+
+			h.ServeHTTP(w, r)
+		})
+	}
+}
+
+// validKey сравнивает ключ со всеми допустимыми ключами за постоянное время
+func validKey(keys []string, key string) bool {
+	if key == "" {
+		return false
+	}
+
+	valid := 0
+	for _, allowed := range keys {
+		if allowed != "" {
+			valid |= subtle.ConstantTimeCompare([]byte(allowed), []byte(key))
+		}
+	}
+
+	return valid == 1
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/delivery"
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/delivery/deliverytest"
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+// serve выполняет запрос к шлюзу с проверкой ключа "service-a"
+func serve(fake *deliverytest.Fake, req *http.Request) *httptest.ResponseRecorder {
+	gw := &gateway{delivery: fake}
+	rec := httptest.NewRecorder()
+	apiKeys([]string{"service-a"})(gw.routes()).ServeHTTP(rec, req)
+	return rec
+}
+
+func TestAPIKeys(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Header string
+		Value  string
+		Status int
+		Calls  int
+	}{
+		{Name: "api key header", Header: "X-API-Key", Value: "service-a", Status: http.StatusOK, Calls: 1},
+		{Name: "bearer token", Header: "Authorization", Value: "Bearer service-a", Status: http.StatusOK, Calls: 1},
+		{Name: "unknown key", Header: "X-API-Key", Value: "service-b", Status: http.StatusUnauthorized},
+		{Name: "key prefix", Header: "X-API-Key", Value: "service", Status: http.StatusUnauthorized},
+		{Name: "no key", Status: http.StatusUnauthorized},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			fake := deliverytest.New().
+				Return("GetRequestHistory", &delivery.GetRequestHistoryResponse{}, nil)
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/requests/r1/history", nil)
+			if c.Header != "" {
+				req.Header.Set(c.Header, c.Value)
+			}
+
+			rec := serve(fake, req)
+			assert.Equal(t, c.Status, rec.Code)
+			assert.Len(t, fake.Calls("GetRequestHistory"), c.Calls)
+		})
+	}
+}
+
+func TestGateway_Errors(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Err    error
+		Path   string
+		Body   string
+		Status int
+	}{
+		{
+			Name:   "upstream validation error",
+			Err:    &utils.ResponseError{StatusCode: http.StatusBadRequest, Body: []byte(`{"code":"validation_error"}`)},
+			Status: http.StatusBadRequest,
+		},
+		{
+			Name:   "upstream not found",
+			Err:    &utils.ResponseError{StatusCode: http.StatusNotFound},
+			Status: http.StatusNotFound,
+		},
+		{
+			Name:   "upstream authorization error",
+			Err:    &utils.ResponseError{StatusCode: http.StatusUnauthorized},
+			Status: http.StatusBadGateway,
+		},
+		{
+			Name:   "upstream server error",
+			Err:    &utils.ResponseError{StatusCode: http.StatusInternalServerError},
+			Status: http.StatusBadGateway,
+		},
+		{
+			Name:   "network error",
+			Err:    errors.New("connection reset"),
+			Status: http.StatusBadGateway,
+		},
+		{
+			Name:   "invalid body",
+			Body:   "{",
+			Status: http.StatusBadRequest,
+		},
+		{
+			Name:   "invalid query",
+			Path:   "/v1/price?oversized=maybe",
+			Status: http.StatusBadRequest,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			fake := deliverytest.New().Return("GetPredictedPrice", nil, c.Err)
+
+			path, body := "/v1/price", "{}"
+			if c.Path != "" {
+				path = c.Path
+			}
+			if c.Body != "" {
+				body = c.Body
+			}
+
+			req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+			req.Header.Set("X-API-Key", "service-a")
+
+			rec := serve(fake, req)
+			assert.Equal(t, c.Status, rec.Code)
+
+			res := response{}
+			if assert.NoError(t, json.NewDecoder(rec.Body).Decode(&res)) {
+				assert.False(t, res.Success)
+			}
+		})
+	}
+}
@@ -0,0 +1,333 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/delivery"
+)
+
+// input флаг с путем к JSON телу запроса
+type input struct {
+	File string `long:"file" short:"f" default:"-" description:"path to JSON request body (- for stdin)"`
+}
+
+type PriceCommand struct {
+	input
+	Oversized bool `long:"oversized" description:"oversized cargo"`
+}
+
+func (c *PriceCommand) Execute([]string) error {
+	req := delivery.PredictPriceRequest{}
+	if err := readInput(c.File, &req); err != nil {
+		return err
+	}
+
+	d, err := client()
+	if err != nil {
+		return err
+	}
+
+	resp, err := d.GetPredictedPrice(c.Oversized, req)
+	if err != nil {
+		return err
+	}
+
+	return render(resp, func() table {
+		t := table{header: []string{"total", "pricing", "commission", "commission amount"}}
+		t.add(resp.PricingTotal, resp.Pricing, resp.PricingCommissionOnDeliveryPayment, resp.PricingCommissionOnDeliveryPaymentAmount)
+		return t
+	})
+}
+
+type IntervalsCommand struct {
+	input
+	Oversized bool   `long:"oversized" description:"oversized cargo"`
+	Policy    string `long:"policy" choice:"time_interval" choice:"self_pickup" default:"time_interval" description:"last mile policy"`
+}
+
+func (c *IntervalsCommand) Execute([]string) error {
+	req := delivery.DeliveryIntervalsRequest{}
+	if err := readInput(c.File, &req); err != nil {
+		return err
+	}
+
+	d, err := client()
+	if err != nil {
+		return err
+	}
+
+	resp, err := d.GetDeliveryIntervals(c.Oversized, delivery.LastMilePolicy(c.Policy), req)
+	if err != nil {
+		return err
+	}
+
+	return render(resp, func() table {
+		t := table{header: []string{"from", "to", "by post"}}
+		for _, offer := range resp.Offers {
+			t.add(offer.From, offer.To, offer.DeliveredByPost)
+		}
+		return t
+	})
+}
+
+type PointsCommand struct {
+	GeoID         int64    `long:"geo-id" description:"locality identifier"`
+	Address       string   `long:"address" description:"locality address, used to detect geo-id"`
+	IDs           []string `long:"id" description:"pickup point identifier (can be repeated)"`
+	Type          string   `long:"type" choice:"pickup_point" choice:"terminal" choice:"post_office" choice:"sorting_center" description:"point type"`
+	PaymentMethod string   `long:"payment-method" choice:"already_paid" choice:"card_on_receipt" choice:"cash_on_delivery" description:"payment method"`
+}
+
+func (c *PointsCommand) Execute([]string) error {
+	d, err := client()
+	if err != nil {
+		return err
+	}
+
+	if c.GeoID == 0 && c.Address != "" {
+		loc, err := d.GetLocationID(c.Address)
+		if err != nil {
+			return err
+		}
+
+		if len(loc.Variants) == 0 {
+			return fmt.Errorf("location not found: %s", c.Address)
+		}
+
+		c.GeoID = loc.Variants[0].GeoID
+	}
+
+	resp, err := d.GetDeliveryPoints(delivery.DeliveryPointsRequest{
+		PickupPointIDS: c.IDs,
+		GeoID:          c.GeoID,
+		Type:           delivery.PickupStationType(c.Type),
+		PaymentMethod:  delivery.PaymentMethod(c.PaymentMethod),
+	})
+	if err != nil {
+		return err
+	}
+
+	return render(resp, func() table {
+		t := table{header: []string{"id", "type", "name", "address"}}
+		for _, point := range resp.Points {
+			t.add(point.ID, point.Type, point.Name, point.Address.FullAddress)
+		}
+		return t
+	})
+}
+
+type OfferCreateCommand struct {
+	input
+}
+
+func (c *OfferCreateCommand) Execute([]string) error {
+	req := delivery.CreateOfferRequest{}
+	if err := readInput(c.File, &req); err != nil {
+		return err
+	}
+
+	d, err := client()
+	if err != nil {
+		return err
+	}
+
+	resp, err := d.CreateOffer(req)
+	if err != nil {
+		return err
+	}
+
+	return render(resp, func() table {
+		t := table{header: []string{"offer id", "expires at", "delivery from", "delivery to", "policy", "total"}}
+		for _, offer := range resp.Offers {
+			interval := offer.OfferDetails.DeliveryInterval
+			t.add(offer.OfferID, offer.ExpiresAt, interval.Min, interval.Max, interval.Policy, offer.OfferDetails.PricingTotal)
+		}
+		return t
+	})
+}
+
+type OfferConfirmCommand struct {
+	Args struct {
+		OfferID string `positional-arg-name:"offer_id" required:"yes"`
+	} `positional-args:"yes"`
+}
+
+func (c *OfferConfirmCommand) Execute([]string) error {
+	d, err := client()
+	if err != nil {
+		return err
+	}
+
+	resp, err := d.ConfirmOffer(c.Args.OfferID)
+	if err != nil {
+		return err
+	}
+
+	return render(resp, func() table {
+		t := table{header: []string{"request id"}}
+		t.add(resp.RequestID)
+		return t
+	})
+}
+
+// requestArgs позиционный аргумент с идентификатором заказа
+type requestArgs struct {
+	Args struct {
+		RequestID string `positional-arg-name:"request_id" required:"yes"`
+	} `positional-args:"yes"`
+}
+
+type RequestInfoCommand struct {
+	requestArgs
+	Slim bool `long:"slim" description:"short order information"`
+}
+
+func (c *RequestInfoCommand) Execute([]string) error {
+	d, err := client()
+	if err != nil {
+		return err
+	}
+
+	resp, err := d.GetRequestInfo(c.Args.RequestID, c.Slim)
+	if err != nil {
+		return err
+	}
+
+	return render(resp, func() table {
+		t := table{header: []string{"request id", "status", "description", "updated at", "sharing url"}}
+		t.add(resp.RequestID, resp.State.Status, resp.State.Description, resp.State.TimestampUTC, resp.SharingURL)
+		return t
+	})
+}
+
+type RequestHistoryCommand struct {
+	requestArgs
+}
+
+func (c *RequestHistoryCommand) Execute([]string) error {
+	d, err := client()
+	if err != nil {
+		return err
+	}
+
+	resp, err := d.GetRequestHistory(c.Args.RequestID)
+	if err != nil {
+		return err
+	}
+
+	return render(resp, func() table {
+		t := table{header: []string{"timestamp", "status", "description", "reason"}}
+		for _, state := range resp.StateHistory {
+			t.add(state.TimestampUTC, state.Status, state.Description, state.Reason)
+		}
+		return t
+	})
+}
+
+type RequestCancelCommand struct {
+	requestArgs
+	Reason string `long:"reason" description:"cancel reason, e.g. SHOP_CANCELLED"`
+}
+
+func (c *RequestCancelCommand) Execute([]string) error {
+	d, err := client()
+	if err != nil {
+		return err
+	}
+
+	resp, err := d.CancelRequestWithReason(c.Args.RequestID, delivery.Reason(c.Reason))
+	if err != nil {
+		return err
+	}
+
+	return render(resp, func() table {
+		t := table{header: []string{"status", "description"}}
+		t.add(resp.Status, resp.Description)
+		return t
+	})
+}
+
+type RequestEditCommand struct {
+	input
+}
+
+func (c *RequestEditCommand) Execute([]string) error {
+	req := delivery.EditRequestInfoRequest{}
+	if err := readInput(c.File, &req); err != nil {
+		return err
+	}
+
+	d, err := client()
+	if err != nil {
+		return err
+	}
+
+	resp, err := d.EditRequestInfo(req)
+	if err != nil {
+		return err
+	}
+
+	return render(resp, func() table {
+		t := table{header: []string{"state", "type", "status", "code", "reason"}}
+		for _, u := range resp.CompletedUpdates {
+			t.add("completed", u.Type, u.Status, u.Code, u.Reason)
+		}
+		for _, u := range resp.ActiveUpdates {
+			t.add("active", u.Type, u.Status, u.Code, u.Reason)
+		}
+		for _, u := range resp.IgnoredUpdates {
+			t.add("ignored", u.Type, u.Status, u.Code, u.Reason)
+		}
+		return t
+	})
+}
+
+// requestsArgs позиционные аргументы со списком заказов
+type requestsArgs struct {
+	Out  string `long:"out" default:"-" description:"output file path (- for stdout)"`
+	Args struct {
+		RequestIDs []string `positional-arg-name:"request_id" required:"1"`
+	} `positional-args:"yes"`
+}
+
+type LabelsCommand struct {
+	requestsArgs
+	Type     string `long:"type" choice:"one" choice:"many" default:"one" description:"labels per page"`
+	Language string `long:"language" default:"ru" description:"labels language"`
+}
+
+func (c *LabelsCommand) Execute([]string) error {
+	d, err := client()
+	if err != nil {
+		return err
+	}
+
+	labels, err := d.GenerateRequestLabels(delivery.GenerateRequestLabelsRequest{
+		RequestIDS:   c.Args.RequestIDs,
+		GenerateType: c.Type,
+		Language:     c.Language,
+	})
+	if err != nil {
+		return err
+	}
+
+	return writeOutput(c.Out, labels)
+}
+
+type ActCommand struct {
+	requestsArgs
+}
+
+func (c *ActCommand) Execute([]string) error {
+	d, err := client()
+	if err != nil {
+		return err
+	}
+
+	act, err := d.GetRequestHandoverAct(c.Args.RequestIDs...)
+	if err != nil {
+		return err
+	}
+
+	return writeOutput(c.Out, act)
+}
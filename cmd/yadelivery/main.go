@@ -0,0 +1,119 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/api"
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/delivery"
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/utils"
+	"github.com/umputun/go-flags"
+)
+
+// options параметры запуска утилиты
+type options struct {
+	Token   string        `long:"token" env:"YANDEX_DELIVERY_TOKEN" description:"API token"`
+	Config  string        `long:"config" env:"YANDEX_DELIVERY_CONFIG" description:"path to JSON config file (default: $XDG_CONFIG_HOME/yadelivery/config.json)"`
+	Dev     bool          `long:"dev" description:"use development environment"`
+	Output  string        `long:"output" short:"o" choice:"table" choice:"json" default:"table" description:"output format"`
+	Timeout time.Duration `long:"timeout" default:"60s" description:"http request timeout"`
+
+	Price     PriceCommand     `command:"price" description:"predicted delivery price"`
+	Intervals IntervalsCommand `command:"intervals" description:"available delivery intervals"`
+	Points    PointsCommand    `command:"points" description:"pickup points list"`
+
+	Offer struct {
+		Create  OfferCreateCommand  `command:"create" description:"create offers for order"`
+		Confirm OfferConfirmCommand `command:"confirm" description:"confirm offer"`
+	} `command:"offer" description:"offers management"`
+
+	Request struct {
+		Info    RequestInfoCommand    `command:"info" description:"order information"`
+		History RequestHistoryCommand `command:"history" description:"order status history"`
+		Cancel  RequestCancelCommand  `command:"cancel" description:"cancel order"`
+		Edit    RequestEditCommand    `command:"edit" description:"edit order information"`
+	} `command:"request" description:"orders management"`
+
+	Labels LabelsCommand `command:"labels" description:"generate order labels"`
+	Act    ActCommand    `command:"act" description:"get handover act"`
+}
+
+var (
+	opts options
+
+	// transport используется HTTP клиентом утилиты, заменяется в тестах
+	transport = http.DefaultTransport
+)
+
+// config файл конфигурации утилиты
+type config struct {
+	Token string `json:"token"`
+	Dev   bool   `json:"dev"`
+}
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		if e, ok := err.(*flags.Error); ok && e.Type == flags.ErrHelp {
+			os.Exit(0)
+		}
+		os.Exit(1)
+	}
+}
+
+// run разбирает аргументы и выполняет выбранную команду
+func run(args []string) error {
+	_, err := flags.NewParser(&opts, flags.Default).ParseArgs(args)
+	return err
+}
+
+// client создает клиент API доставки на основе параметров запуска.
+// Значения из файла конфигурации переопределяются флагами и переменными окружения
+func client() (delivery.DeliveryClient, error) {
+	cfg, err := loadConfig(opts.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	token, dev := cfg.Token, cfg.Dev || opts.Dev
+	if opts.Token != "" {
+		token = opts.Token
+	}
+
+	if token == "" {
+		return nil, errors.New("token is required: use --token, YANDEX_DELIVERY_TOKEN or config file")
+	}
+
+	env := utils.Production
+	if dev {
+		env = utils.Development
+	}
+
+	return api.New(env, &http.Client{Timeout: opts.Timeout, Transport: transport}, token).Delivery(), nil
+}
+
+func loadConfig(path string) (config, error) {
+	cfg := config{}
+
+	if path == "" {
+		dir, err := os.UserConfigDir()
+		if err != nil {
+			return cfg, nil
+		}
+
+		path = filepath.Join(dir, "yadelivery", "config.json")
+		if _, err := os.Stat(path); err != nil {
+			return cfg, nil
+		}
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return cfg, err
+	}
+
+	return cfg, json.Unmarshal(data, &cfg)
+}
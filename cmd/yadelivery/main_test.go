@@ -0,0 +1,143 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/delivery"
+	"github.com/stretchr/testify/assert"
+)
+
+// serverTransport направляет запросы утилиты на тестовый сервер
+type serverTransport struct {
+	server *httptest.Server
+}
+
+func (t serverTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	target, _ := url.Parse(t.server.URL)
+	req.URL.Scheme, req.URL.Host = target.Scheme, target.Host
+	return t.server.Client().Transport.RoundTrip(req)
+}
+
+// runCommand выполняет команду утилиты с тестовым сервером и возвращает вывод
+func runCommand(t *testing.T, handler http.Handler, args ...string) (string, error) {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	// Файл конфигурации пользователя не влияет на тесты
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+
+	out := &bytes.Buffer{}
+	opts, transport, stdout = options{}, serverTransport{server: server}, out
+	t.Cleanup(func() { transport, stdout = http.DefaultTransport, os.Stdout })
+
+	err := run(append([]string{"--token", "token"}, args...))
+	return out.String(), err
+}
+
+func TestRequestInfoCommand(t *testing.T) {
+	updated, _ := delivery.ParseTimestamp("2025-03-01T10:00:00.5Z")
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/b2b/platform/request/info", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		assert.Equal(t, "r1", r.URL.Query().Get("request_id"))
+
+		json.NewEncoder(w).Encode(delivery.GetRequestInfoResponse{
+			RequestID: "r1",
+			State:     delivery.State{Status: "DELIVERY_PROCESSING_STARTED", TimestampUTC: updated},
+		})
+	})
+
+	out, err := runCommand(t, mux, "request", "info", "r1")
+	assert.NoError(t, err)
+	assert.Contains(t, out, "REQUEST ID")
+	assert.Contains(t, out, "DELIVERY_PROCESSING_STARTED")
+	assert.Contains(t, out, updated.Local().Format(time.DateTime))
+
+	out, err = runCommand(t, mux, "-o", "json", "request", "info", "r1")
+	assert.NoError(t, err)
+
+	resp := delivery.GetRequestInfoResponse{}
+	if assert.NoError(t, json.Unmarshal([]byte(out), &resp)) {
+		assert.Equal(t, "r1", resp.RequestID)
+		assert.True(t, updated.Equal(resp.State.TimestampUTC.Time))
+	}
+}
+
+func TestCommand_Config(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	assert.NoError(t, os.WriteFile(path, []byte(`{"token": "file-token", "dev": true}`), 0o600))
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/b2b/platform/request/info", func(w http.ResponseWriter, r *http.Request) {
+		// Токен из флага, окружение из файла конфигурации
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		assert.Equal(t, "b2b.taxi.tst.yandex.net", r.Host)
+
+		json.NewEncoder(w).Encode(delivery.GetRequestInfoResponse{RequestID: "r1"})
+	})
+
+	_, err := runCommand(t, mux, "--config", path, "request", "info", "r1")
+	assert.NoError(t, err)
+}
+
+func TestRequestHistoryCommand(t *testing.T) {
+	updated, _ := delivery.ParseTimestamp("2025-03-01T10:00:00Z")
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/b2b/platform/request/history", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(delivery.GetRequestHistoryResponse{StateHistory: []delivery.StateHistory{
+			{Status: "CREATED", TimestampUTC: updated},
+		}})
+	})
+
+	out, err := runCommand(t, mux, "request", "history", "r1")
+	assert.NoError(t, err)
+	assert.Contains(t, out, "CREATED")
+	assert.Contains(t, out, updated.Local().Format(time.DateTime))
+}
+
+func TestLabelsCommand(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/b2b/platform/request/generate-labels", func(w http.ResponseWriter, r *http.Request) {
+		req := delivery.GenerateRequestLabelsRequest{}
+		json.NewDecoder(r.Body).Decode(&req)
+		assert.Equal(t, []string{"r1", "r2"}, req.RequestIDS)
+		assert.Equal(t, "many", req.GenerateType)
+
+		w.Write([]byte("%PDF-labels"))
+	})
+	mux.HandleFunc("/api/b2b/platform/request/get-handover-act", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("%PDF-act"))
+	})
+
+	path := filepath.Join(t.TempDir(), "labels.pdf")
+	_, err := runCommand(t, mux, "labels", "--type", "many", "--out", path, "r1", "r2")
+	if assert.NoError(t, err) {
+		data, _ := os.ReadFile(path)
+		assert.Equal(t, "%PDF-labels", string(data))
+	}
+
+	out, err := runCommand(t, mux, "act", "r1")
+	assert.NoError(t, err)
+	assert.Equal(t, "%PDF-act", out)
+}
+
+func TestCommand_APIError(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"code":"bad_request"}`))
+	})
+
+	_, err := runCommand(t, handler, "request", "history", "r1")
+	assert.ErrorContains(t, err, "bad_request")
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/delivery"
+)
+
+// stdout поток вывода результатов команд, заменяется в тестах
+var stdout io.Writer = os.Stdout
+
+// table табличное представление ответа API
+type table struct {
+	header []string
+	rows   [][]string
+}
+
+func (t *table) add(cols ...any) {
+	row := make([]string, len(cols))
+	for i, col := range cols {
+		switch v := col.(type) {
+		case time.Time:
+			if !v.IsZero() {
+				row[i] = v.Local().Format(time.DateTime)
+			}
+		case delivery.Timestamp:
+			switch {
+			case v.IsClock():
+				row[i] = v.String()
+			case !v.IsZero():
+				row[i] = v.Local().Format(time.DateTime)
+			}
+		default:
+			row[i] = fmt.Sprint(v)
+		}
+	}
+	t.rows = append(t.rows, row)
+}
+
+// render выводит ответ в выбранном формате
+func render(v any, tbl func() table) error {
+	if opts.Output == "json" {
+		enc := json.NewEncoder(stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	}
+
+	t := tbl()
+	w := tabwriter.NewWriter(stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, strings.ToUpper(strings.Join(t.header, "\t")))
+	for _, row := range t.rows {
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	return w.Flush()
+}
+
+// readInput читает тело запроса в формате JSON из файла или stdin
+func readInput(path string, v any) error {
+	var r io.Reader = os.Stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	return json.NewDecoder(r).Decode(v)
+}
+
+// writeOutput записывает файл ответа по пути path или в stdout
+func writeOutput(path string, rc io.ReadCloser) error {
+	defer rc.Close()
+
+	w := stdout
+	if path != "-" {
+		f, err := os.Create(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	_, err := io.Copy(w, rc)
+	return err
+}
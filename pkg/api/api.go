@@ -1,33 +1,180 @@
 package api
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"time"
 
 	"github.com/ReanSn0w/go-yandex-delivery/pkg/delivery"
 	"github.com/ReanSn0w/go-yandex-delivery/pkg/utils"
 	"github.com/ReanSn0w/gokit/pkg/web"
 )
 
+// New создает клиент API.
+// Запросы первыми проходят через Auth с переданным токеном (см. WithAuth),
+// по умолчанию в цепочке также находится TracePropagation
 func New(environment utils.Environment, client web.HTTPClient, token string) *API {
-	return &API{
+	a := &API{
 		environment: environment,
 		client:      client,
-		token:       token,
+		auth:        Auth(token),
 	}
+
+	return a.WithMiddlewares(TracePropagation())
 }
 
+// API клиент API доставки.
+// Методы настройки не изменяют клиент, а возвращают его копию,
+// поэтому их можно вызывать, пока выполняются запросы
 type API struct {
 	environment utils.Environment
 	client      web.HTTPClient
-	token       string
+	auth        Middleware
+	layers      []layer
+	hooks       []ResponseHook
+	handler     web.HTTPClient
+	metrics     utils.Metrics
+}
+
+// layer создает middleware для клиента, в котором она используется
+type layer func(a *API) Middleware
+
+// ResponseHook получает разобранный ответ метода API,
+// например *delivery.CreateOfferResponse, и может его изменить.
+// Ошибка обработчика возвращается вызывающему вместо ответа
+type ResponseHook func(ctx context.Context, res any) error
+
+// Use возвращает копию клиента с middleware, добавленными в конец цепочки.
+// Первая добавленная middleware получает запрос первой, после Auth
+func (a *API) Use(middlewares ...Middleware) *API {
+	layers := make([]layer, len(middlewares))
+	for i, m := range middlewares {
+		layers[i] = func(*API) Middleware { return m }
+	}
+	return a.with(layers...)
+}
+
+// WithMiddlewares возвращает копию клиента с новой цепочкой обработки запросов,
+// включая middleware, установленные по умолчанию.
+// Auth сохраняется и выполняется первым
+func (a *API) WithMiddlewares(middlewares ...Middleware) *API {
+	c := *a
+	c.layers = nil
+	return c.Use(middlewares...)
+}
+
+// WithAuth возвращает копию клиента с другой middleware авторизации.
+// Она выполняется первой, до middleware из Use и WithMiddlewares.
+// nil отключает авторизацию
+func (a *API) WithAuth(auth Middleware) *API {
+	c := *a
+	c.auth = auth
+	return c.with()
 }
 
-func (a *API) Delivery() *delivery.Delivery {
+// WithLimiter добавляет в цепочку ограничитель частоты запросов.
+// Ограничитель общий для всех запросов, выполняемых через API
+func (a *API) WithLimiter(limiter utils.Limiter) *API {
+	return a.with(func(c *API) Middleware {
+		return RateLimit(limiter, func(endpoint string, wait time.Duration) {
+			if c.metrics != nil {
+				c.metrics.ObserveRateLimitWait(endpoint, wait)
+			}
+		})
+	})
+}
+
+// WithMetrics добавляет в цепочку сбор метрик запросов
+func (a *API) WithMetrics(metrics utils.Metrics) *API {
+	c := *a
+	c.metrics = metrics
+	return c.Use(metrics.Client)
+}
+
+// WithBreaker добавляет в цепочку автоматический выключатель.
+// Пока выключатель разомкнут, запросы к методу завершаются ошибкой ErrCircuitOpen
+func (a *API) WithBreaker(breaker *Breaker) *API {
+	return a.Use(breaker.Middleware())
+}
+
+// WithResponseHook добавляет обработчики разобранных ответов.
+// Обработчики вызываются по порядку после успешного разбора ответа
+func (a *API) WithResponseHook(hooks ...ResponseHook) *API {
+	c := *a
+	c.hooks = append(slices.Clip(a.hooks), hooks...)
+	return &c
+}
+
+// ObserveResponse передает разобранный ответ обработчикам WithResponseHook
+func (a *API) ObserveResponse(ctx context.Context, res any) error {
+	for _, hook := range a.hooks {
+		if err := hook(ctx, res); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// with возвращает копию клиента с добавленными слоями
+// и заново собранной цепочкой middleware
+func (a *API) with(layers ...layer) *API {
+	c := *a
+	c.layers = append(slices.Clip(a.layers), layers...)
+
+	middlewares := make([]Middleware, 0, len(c.layers)+1)
+	if c.auth != nil {
+		middlewares = append(middlewares, c.auth)
+	}
+	for _, l := range c.layers {
+		middlewares = append(middlewares, l(&c))
+	}
+	c.handler = chain(c.client, middlewares)
+
+	return &c
+}
+
+// Delivery возвращает клиент API доставки
+func (a *API) Delivery() delivery.DeliveryClient {
 	return delivery.New(a, a.environment)
 }
 
 func (a *API) Request(base, path string) *web.JsonRequest {
-	return web.NewJsonRequest(a.client, fmt.Sprintf("%v%v", base, path)).
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("Bearer %v", a.token))
+	return a.RequestContext(context.Background(), base, path)
+}
+
+// RequestContext создает запрос, выполнение которого
+// ограничено переданным контекстом
+func (a *API) RequestContext(ctx context.Context, base, path string) *web.JsonRequest {
+	ctx = utils.WithEndpoint(ctx, path)
+	return web.NewJsonRequest(&contextClient{ctx: ctx, handler: a.handler}, fmt.Sprintf("%v%v", base, path)).
+		SetHeader("Content-Type", "application/json")
+}
+
+// Download выполняет POST запрос с JSON телом через цепочку middleware
+// и возвращает тело ответа без разбора.
+// Ответ с кодом ошибки возвращается в виде utils.ResponseError, как и для JSON запросов
+func (a *API) Download(ctx context.Context, base, path string, body any) (io.ReadCloser, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = utils.WithEndpoint(ctx, path)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%v%v", base, path), bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := do(a.handler, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Body, nil
 }
@@ -0,0 +1,122 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/api"
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+type testLimiter struct {
+	calls int
+	err   error
+}
+
+func (l *testLimiter) Wait(ctx context.Context) error {
+	l.calls++
+	if l.err != nil {
+		return l.err
+	}
+	return ctx.Err()
+}
+
+func TestAPI_RequestContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	limiter := &testLimiter{}
+	a := api.New(utils.Development, server.Client(), "token").WithLimiter(limiter)
+
+	t.Run("limiter is used", func(t *testing.T) {
+		res := map[string]any{}
+		err := a.RequestContext(context.Background(), server.URL, "/").Do(&res)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, limiter.calls)
+	})
+
+	t.Run("cancelled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		res := map[string]any{}
+		err := a.RequestContext(ctx, server.URL, "/").Do(&res)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("limiter error", func(t *testing.T) {
+		limiter.err = errors.New("limited")
+
+		res := map[string]any{}
+		err := a.Request(server.URL, "/").Do(&res)
+		assert.ErrorIs(t, err, limiter.err)
+	})
+}
+
+func TestAPI_TracePropagation(t *testing.T) {
+	prev := otel.GetTextMapPropagator()
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() { otel.SetTextMapPropagator(prev) })
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NotEmpty(t, r.Header.Get("traceparent"))
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	ctx, span := sdktrace.NewTracerProvider().Tracer("test").Start(context.Background(), "test")
+	defer span.End()
+
+	res := map[string]any{}
+	err := api.New(utils.Development, server.Client(), "token").
+		RequestContext(ctx, server.URL, "/").
+		Do(&res)
+	assert.NoError(t, err)
+}
+
+func TestAPI_Download(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"code":"not_found"}`))
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"request_ids":["r1"]}`, string(body))
+		w.Write([]byte("%PDF-1.4"))
+	}))
+	defer server.Close()
+
+	a := api.New(utils.Development, server.Client(), "token")
+	body := map[string]any{"request_ids": []string{"r1"}}
+
+	file, err := a.Download(context.Background(), server.URL, "/labels", body)
+	if assert.NoError(t, err) {
+		defer file.Close()
+		data, _ := io.ReadAll(file)
+		assert.Equal(t, "%PDF-1.4", string(data))
+	}
+
+	_, err = a.Download(context.Background(), server.URL, "/missing", body)
+
+	respErr := &utils.ResponseError{}
+	if assert.ErrorAs(t, err, &respErr) {
+		assert.Equal(t, http.StatusNotFound, respErr.StatusCode)
+		assert.JSONEq(t, `{"code":"not_found"}`, string(respErr.Body))
+	}
+}
@@ -0,0 +1,222 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/utils"
+	"github.com/ReanSn0w/gokit/pkg/web"
+)
+
+var (
+	ErrCircuitOpen = errors.New("circuit breaker is open")
+)
+
+// CircuitOpenError возвращается, когда запросы к методу API
+// временно не выполняются из-за большого количества ошибок
+type CircuitOpenError struct {
+	Endpoint   string    // Путь метода API
+	RetryAfter time.Time // Время, после которого будет выполнен пробный запрос
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("%v: %s (retry after %v)", ErrCircuitOpen, e.Endpoint, e.RetryAfter.Format(time.RFC3339))
+}
+
+func (e *CircuitOpenError) Is(target error) bool {
+	return target == ErrCircuitOpen
+}
+
+// BreakerOptions настройки автоматического выключателя
+type BreakerOptions struct {
+	// Окно, в котором подсчитывается доля ошибок.
+	// Значение по умолчанию: 1 минута
+	Window time.Duration
+
+	// Минимальное количество запросов в окне для размыкания.
+	// Значение по умолчанию: 10
+	MinRequests int
+
+	// Доля ошибок, при достижении которой выключатель размыкается.
+	// Значение по умолчанию: 0.5
+	FailureRatio float64
+
+	// Время, через которое разомкнутый выключатель пропускает пробный запрос.
+	// Значение по умолчанию: 30 секунд
+	OpenTimeout time.Duration
+
+	// Определяет, считается ли ответ ошибкой.
+	// По умолчанию ошибками считаются сетевые ошибки и ответы 429 и 5xx.
+	// Отмена контекста и ошибки ожидания ограничителя частоты запросов
+	// не учитываются ни как ошибка, ни как успешный запрос
+	IsFailure func(resp *http.Response, err error) bool
+}
+
+// NewBreaker создает автоматический выключатель
+// с отдельным состоянием для каждого метода API
+func NewBreaker(opts BreakerOptions) *Breaker {
+	if opts.Window <= 0 {
+		opts.Window = time.Minute
+	}
+	if opts.MinRequests <= 0 {
+		opts.MinRequests = 10
+	}
+	if opts.FailureRatio <= 0 {
+		opts.FailureRatio = 0.5
+	}
+	if opts.OpenTimeout <= 0 {
+		opts.OpenTimeout = 30 * time.Second
+	}
+	if opts.IsFailure == nil {
+		opts.IsFailure = isFailure
+	}
+
+	return &Breaker{
+		opts:      opts,
+		endpoints: make(map[string]*circuit),
+		now:       time.Now,
+	}
+}
+
+type Breaker struct {
+	opts      BreakerOptions
+	mutex     sync.Mutex
+	endpoints map[string]*circuit
+	now       func() time.Time
+}
+
+type circuitState int
+
+const (
+	closed circuitState = iota
+	open
+	halfOpen
+)
+
+// circuit состояние выключателя для одного метода API
+type circuit struct {
+	state       circuitState
+	windowStart time.Time
+	requests    int
+	failures    int
+	openedUntil time.Time
+	probing     bool // Пробный запрос выполняется
+}
+
+// Middleware возвращает middleware, применяющую выключатель к запросам
+func (b *Breaker) Middleware() Middleware {
+	return func(next web.HTTPClient) web.HTTPClient {
+		return ClientFunc(func(req *http.Request) (*http.Response, error) {
+			done, err := b.allow(utils.Endpoint(req.Context()))
+			if err != nil {
+				return nil, err
+			}
+
+			resp, err := next.Do(req)
+			done(resp, err)
+			return resp, err
+		})
+	}
+}
+
+// allow проверяет возможность выполнить запрос к методу API.
+// Функция done должна быть вызвана с результатом запроса
+func (b *Breaker) allow(endpoint string) (done func(*http.Response, error), err error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	now := b.now()
+	c, ok := b.endpoints[endpoint]
+	if !ok {
+		c = &circuit{windowStart: now}
+		b.endpoints[endpoint] = c
+	}
+
+	if c.state == open {
+		if now.Before(c.openedUntil) {
+			return nil, &CircuitOpenError{Endpoint: endpoint, RetryAfter: c.openedUntil}
+		}
+		c.state = halfOpen
+	}
+
+	probe := c.state == halfOpen
+	if probe {
+		if c.probing {
+			return nil, &CircuitOpenError{Endpoint: endpoint, RetryAfter: c.openedUntil}
+		}
+		c.probing = true
+	}
+
+	return func(resp *http.Response, err error) {
+		if neutral(err) {
+			b.release(c, probe)
+			return
+		}
+		b.done(c, probe, b.opts.IsFailure(resp, err))
+	}, nil
+}
+
+// release завершает запрос, результат которого не учитывается.
+// После такого пробного запроса выключатель пропускает следующий пробный запрос
+func (b *Breaker) release(c *circuit, probe bool) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if probe {
+		c.probing = false
+	}
+}
+
+func (b *Breaker) done(c *circuit, probe, failed bool) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	now := b.now()
+
+	if probe {
+		c.probing = false
+		if failed {
+			c.state = open
+			c.openedUntil = now.Add(b.opts.OpenTimeout)
+		} else {
+			c.state = closed
+			c.windowStart, c.requests, c.failures = now, 0, 0
+		}
+		return
+	}
+
+	if c.state != closed {
+		return
+	}
+
+	if now.Sub(c.windowStart) > b.opts.Window {
+		c.windowStart, c.requests, c.failures = now, 0, 0
+	}
+
+	c.requests++
+	if failed {
+		c.failures++
+	}
+
+	if c.requests >= b.opts.MinRequests && float64(c.failures)/float64(c.requests) >= b.opts.FailureRatio {
+		c.state = open
+		c.openedUntil = now.Add(b.opts.OpenTimeout)
+	}
+}
+
+// neutral проверяет, что запрос не дошел до API по причинам на стороне клиента
+func neutral(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, ErrRateLimitWait)
+}
+
+func isFailure(resp *http.Response, err error) bool {
+	if err != nil {
+		return !neutral(err)
+	}
+
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+}
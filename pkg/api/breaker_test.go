@@ -0,0 +1,124 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/api"
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBreaker(t *testing.T) {
+	var (
+		failing atomic.Bool
+		calls   atomic.Int32
+	)
+	failing.Store(true)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if failing.Load() && r.URL.Path == "/offers/info" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	a := api.New(utils.Development, server.Client(), "token").
+		WithBreaker(api.NewBreaker(api.BreakerOptions{
+			MinRequests:  4,
+			FailureRatio: 0.5,
+			OpenTimeout:  50 * time.Millisecond,
+		}))
+
+	do := func(path string) error {
+		res := map[string]any{}
+		return a.Request(server.URL, path).Do(&res)
+	}
+
+	for range 4 {
+		err := do("/offers/info")
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, api.ErrCircuitOpen)
+	}
+
+	// Выключатель разомкнут только для метода с ошибками
+	err := do("/offers/info")
+	assert.ErrorIs(t, err, api.ErrCircuitOpen)
+	openErr := &api.CircuitOpenError{}
+	if assert.True(t, errors.As(err, &openErr)) {
+		assert.Equal(t, "/offers/info", openErr.Endpoint)
+	}
+	assert.Equal(t, int32(4), calls.Load())
+	assert.NoError(t, do("/pickup-points/list"))
+
+	// Неудачный пробный запрос снова размыкает выключатель
+	time.Sleep(60 * time.Millisecond)
+	assert.NotErrorIs(t, do("/offers/info"), api.ErrCircuitOpen)
+	assert.ErrorIs(t, do("/offers/info"), api.ErrCircuitOpen)
+
+	// Успешный пробный запрос замыкает выключатель
+	failing.Store(false)
+	time.Sleep(60 * time.Millisecond)
+	assert.NoError(t, do("/offers/info"))
+	assert.NoError(t, do("/offers/info"))
+}
+
+func TestBreaker_NeutralErrors(t *testing.T) {
+	var failing atomic.Bool
+	failing.Store(true)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if failing.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	limiter := &testLimiter{}
+	a := api.New(utils.Development, server.Client(), "token").
+		WithBreaker(api.NewBreaker(api.BreakerOptions{
+			MinRequests:  2,
+			FailureRatio: 1,
+			OpenTimeout:  50 * time.Millisecond,
+		})).
+		WithLimiter(limiter)
+
+	do := func(ctx context.Context) error {
+		res := map[string]any{}
+		return a.RequestContext(ctx, server.URL, "/offers/info").Do(&res)
+	}
+
+	// Ошибка ограничителя частоты не учитывается выключателем
+	limiter.err = context.DeadlineExceeded
+	assert.ErrorIs(t, do(context.Background()), api.ErrRateLimitWait)
+	limiter.err = nil
+
+	assert.NotErrorIs(t, do(context.Background()), api.ErrCircuitOpen)
+	assert.NotErrorIs(t, do(context.Background()), api.ErrCircuitOpen)
+	assert.ErrorIs(t, do(context.Background()), api.ErrCircuitOpen)
+
+	// Отмененный пробный запрос не замыкает выключатель:
+	// следующий запрос остается пробным и после ошибки снова размыкает его
+	time.Sleep(60 * time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, do(ctx), context.Canceled)
+
+	assert.NotErrorIs(t, do(context.Background()), api.ErrCircuitOpen)
+	assert.ErrorIs(t, do(context.Background()), api.ErrCircuitOpen)
+
+	failing.Store(false)
+	time.Sleep(60 * time.Millisecond)
+	assert.NoError(t, do(context.Background()))
+}
@@ -0,0 +1,36 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/utils"
+	"github.com/ReanSn0w/gokit/pkg/web"
+)
+
+// contextClient выполняет запрос в рамках контекста
+// через цепочку middleware
+type contextClient struct {
+	ctx     context.Context
+	handler web.HTTPClient
+}
+
+func (c *contextClient) Do(req *http.Request) (*http.Response, error) {
+	return do(c.handler, req.WithContext(c.ctx))
+}
+
+// do выполняет запрос и возвращает ответ с кодом ошибки
+// в виде utils.ResponseError
+func do(handler web.HTTPClient, req *http.Request) (*http.Response, error) {
+	resp, err := handler.Do(req)
+	if err != nil || resp.StatusCode < 300 {
+		return resp, err
+	}
+
+	body, err := utils.ReadBody(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, &utils.ResponseError{StatusCode: resp.StatusCode, Body: body}
+}
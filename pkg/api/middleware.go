@@ -0,0 +1,79 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/utils"
+	"github.com/ReanSn0w/gokit/pkg/web"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+var (
+	ErrRateLimitWait = errors.New("rate limit wait failed")
+)
+
+// Middleware оборачивает выполнение HTTP запроса к API.
+// Путь метода API доступен через utils.Endpoint(req.Context()),
+// тело ответа можно прочитать без потери через utils.ReadBody
+type Middleware func(next web.HTTPClient) web.HTTPClient
+
+// ClientFunc адаптер функции к интерфейсу web.HTTPClient
+type ClientFunc func(req *http.Request) (*http.Response, error)
+
+func (f ClientFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func chain(client web.HTTPClient, middlewares []Middleware) web.HTTPClient {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		client = middlewares[i](client)
+	}
+	return client
+}
+
+// Auth добавляет в запрос токен авторизации
+func Auth(token string) Middleware {
+	header := fmt.Sprintf("Bearer %v", token)
+
+	return func(next web.HTTPClient) web.HTTPClient {
+		return ClientFunc(func(req *http.Request) (*http.Response, error) {
+			req.Header.Set("Authorization", header)
+			return next.Do(req)
+		})
+	}
+}
+
+// TracePropagation передает контекст трассировки в заголовках запроса
+func TracePropagation() Middleware {
+	return func(next web.HTTPClient) web.HTTPClient {
+		return ClientFunc(func(req *http.Request) (*http.Response, error) {
+			otel.GetTextMapPropagator().Inject(req.Context(), propagation.HeaderCarrier(req.Header))
+			return next.Do(req)
+		})
+	}
+}
+
+// RateLimit ожидает разрешения ограничителя перед выполнением запроса.
+// Функция observe, если указана, получает время ожидания.
+// Ошибка ожидания соответствует ErrRateLimitWait и не считается ошибкой API
+// в Retry и Breaker
+func RateLimit(limiter utils.Limiter, observe func(endpoint string, wait time.Duration)) Middleware {
+	return func(next web.HTTPClient) web.HTTPClient {
+		return ClientFunc(func(req *http.Request) (*http.Response, error) {
+			start := time.Now()
+			err := limiter.Wait(req.Context())
+			if observe != nil {
+				observe(utils.Endpoint(req.Context()), time.Since(start))
+			}
+			if err != nil {
+				return nil, fmt.Errorf("%w: %w", ErrRateLimitWait, err)
+			}
+
+			return next.Do(req)
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/api"
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/delivery"
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/utils"
+	"github.com/ReanSn0w/gokit/pkg/web"
+	"github.com/stretchr/testify/assert"
+)
+
+func header(name, value string) api.Middleware {
+	return func(next web.HTTPClient) web.HTTPClient {
+		return api.ClientFunc(func(req *http.Request) (*http.Response, error) {
+			req.Header.Add(name, value)
+			return next.Do(req)
+		})
+	}
+}
+
+func TestAPI_Use(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Order", r.Header.Get("X-Order"))
+		w.Header().Set("X-Auth", r.Header.Get("Authorization"))
+		w.Write([]byte(`{"code":"ok"}`))
+	}))
+	defer server.Close()
+
+	var order, auth string
+	inspect := func(next web.HTTPClient) web.HTTPClient {
+		return api.ClientFunc(func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "/request/info", utils.Endpoint(req.Context()))
+
+			resp, err := next.Do(req)
+			if err == nil {
+				body, _ := utils.ReadBody(resp)
+				assert.JSONEq(t, `{"code":"ok"}`, string(body))
+				order, auth = resp.Header.Get("X-Order"), resp.Header.Get("X-Auth")
+			}
+			return resp, err
+		})
+	}
+
+	t.Run("use", func(t *testing.T) {
+		a := api.New(utils.Development, server.Client(), "token").
+			Use(inspect, header("X-Order", "first"), header("X-Order", "second"))
+
+		res := map[string]any{}
+		assert.NoError(t, a.Request(server.URL, "/request/info").Do(&res))
+		assert.Equal(t, "ok", res["code"])
+		assert.Equal(t, "first", order)
+		assert.Equal(t, "Bearer token", auth)
+	})
+
+	t.Run("replace defaults keeps auth", func(t *testing.T) {
+		a := api.New(utils.Development, server.Client(), "token").
+			WithMiddlewares(inspect)
+
+		res := map[string]any{}
+		assert.NoError(t, a.Request(server.URL, "/request/info").Do(&res))
+		assert.Equal(t, "Bearer token", auth)
+	})
+
+	t.Run("replace auth", func(t *testing.T) {
+		a := api.New(utils.Development, server.Client(), "token").
+			Use(inspect).
+			WithAuth(header("Authorization", "OAuth other"))
+
+		res := map[string]any{}
+		assert.NoError(t, a.Request(server.URL, "/request/info").Do(&res))
+		assert.Equal(t, "OAuth other", auth)
+
+		assert.NoError(t, a.WithAuth(nil).Request(server.URL, "/request/info").Do(&res))
+		assert.Empty(t, auth)
+	})
+
+	t.Run("use returns copy", func(t *testing.T) {
+		a := api.New(utils.Development, server.Client(), "token")
+		a.Use(inspect, header("X-Order", "copy"))
+
+		order = ""
+		res := map[string]any{}
+		assert.NoError(t, a.Request(server.URL, "/request/info").Do(&res))
+		assert.Empty(t, order)
+	})
+}
+
+func TestRetry(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"request_id":"1"}`, string(body))
+
+		if attempts < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	retries := []string{}
+	a := api.New(utils.Development, server.Client(), "token").
+		Use(api.Retry(api.RetryOptions{
+			Attempts: 3,
+			Delay:    time.Millisecond,
+			OnRetry:  func(endpoint string) { retries = append(retries, endpoint) },
+		}))
+
+	res := map[string]any{}
+	err := a.Request(server.URL, "/request/cancel").
+		SetMethod(http.MethodPost).
+		SetBody(map[string]string{"request_id": "1"}).
+		Do(&res)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, attempts)
+	assert.Equal(t, []string{"/request/cancel", "/request/cancel"}, retries)
+}
+
+func TestRetry_NonIdempotent(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	a := api.New(utils.Development, server.Client(), "token").
+		Use(api.Retry(api.RetryOptions{Delay: time.Millisecond}))
+
+	res := map[string]any{}
+	err := a.Request(server.URL, "/request/create").
+		SetMethod(http.MethodPost).
+		SetBody(map[string]string{"operator_request_id": "1"}).
+		Do(&res)
+
+	respErr := &utils.ResponseError{}
+	if assert.ErrorAs(t, err, &respErr) {
+		assert.Equal(t, http.StatusServiceUnavailable, respErr.StatusCode)
+	}
+	assert.Equal(t, 1, attempts)
+}
+
+func TestAPI_WithResponseHook(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"request_id":"r1"}`))
+	}))
+	defer server.Close()
+
+	rejected := errors.New("rejected")
+	a := api.New(utils.Development, redirect(server), "token")
+
+	seen := []string{}
+	d := a.WithResponseHook(func(ctx context.Context, res any) error {
+		if r, ok := res.(*delivery.CreateRequestResponse); ok {
+			seen = append(seen, r.RequestID)
+		}
+		return nil
+	}).Delivery()
+
+	_, err := d.CreateRequest(delivery.CreateRequestRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"r1"}, seen)
+
+	d = a.WithResponseHook(func(ctx context.Context, res any) error { return rejected }).Delivery()
+	_, err = d.CreateRequest(delivery.CreateRequestRequest{})
+	assert.ErrorIs(t, err, rejected)
+}
@@ -0,0 +1,158 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/delivery"
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/utils"
+	"github.com/ReanSn0w/gokit/pkg/web"
+)
+
+var (
+	ErrUnknownAccount = errors.New("unknown account")
+)
+
+// Account настройки аккаунта отправителя
+type Account struct {
+	Environment       utils.Environment // Окружение API
+	Token             string            // Токен API аккаунта
+	PlatformStationID string            // Склад отправки, подставляемый в запросы без склада
+	Limiter           utils.Limiter     // Ограничитель частоты запросов аккаунта
+	Middlewares       []Middleware      // Дополнительные middleware аккаунта
+}
+
+// AccountRequest заказ с указанием аккаунта, к которому он относится
+type AccountRequest struct {
+	Account string
+	delivery.RequestElement
+}
+
+// NewRegistry создает реестр аккаунтов.
+// Запросы всех аккаунтов выполняются через общий HTTP клиент
+func NewRegistry(client web.HTTPClient) *Registry {
+	return &Registry{
+		client:   client,
+		accounts: make(map[string]registryEntry),
+	}
+}
+
+// Registry хранит клиенты API для нескольких аккаунтов
+type Registry struct {
+	client   web.HTTPClient
+	mutex    sync.RWMutex
+	accounts map[string]registryEntry
+}
+
+type registryEntry struct {
+	account Account
+	api     *API
+}
+
+// Add регистрирует аккаунт под ключом key.
+// Повторная регистрация заменяет настройки аккаунта
+func (r *Registry) Add(key string, account Account) *Registry {
+	a := New(account.Environment, r.client, account.Token)
+	if account.Limiter != nil {
+		a = a.WithLimiter(account.Limiter)
+	}
+	a = a.Use(account.Middlewares...)
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.accounts[key] = registryEntry{account: account, api: a}
+	return r
+}
+
+// Keys возвращает ключи зарегистрированных аккаунтов
+func (r *Registry) Keys() []string {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	keys := make([]string, 0, len(r.accounts))
+	for key := range r.accounts {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// Account возвращает настройки аккаунта
+func (r *Registry) Account(key string) (Account, error) {
+	entry, err := r.entry(key)
+	return entry.account, err
+}
+
+// API возвращает клиент API аккаунта
+func (r *Registry) API(key string) (*API, error) {
+	entry, err := r.entry(key)
+	return entry.api, err
+}
+
+// Delivery возвращает клиент API доставки аккаунта.
+// Склад отправки аккаунта подставляется в запросы, в которых он не указан
+func (r *Registry) Delivery(key string) (delivery.DeliveryClient, error) {
+	entry, err := r.entry(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return delivery.New(entry.api, entry.account.Environment).
+		WithSourceStation(entry.account.PlatformStationID), nil
+}
+
+// GetRequestsInfo получает заказы всех аккаунтов во временном интервале.
+// Запросы к аккаунтам выполняются параллельно. При ошибке части аккаунтов
+// возвращаются заказы остальных вместе с ошибкой
+func (r *Registry) GetRequestsInfo(ctx context.Context, from, to time.Time) ([]AccountRequest, error) {
+	keys := r.Keys()
+
+	var (
+		wg      sync.WaitGroup
+		results = make([][]AccountRequest, len(keys))
+		errs    = make([]error, len(keys))
+	)
+
+	for i, key := range keys {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			d, err := r.Delivery(key)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+
+			resp, err := d.WithContext(ctx).GetRequestsInfo(from, to)
+			if err != nil {
+				errs[i] = fmt.Errorf("account %s: %w", key, err)
+				return
+			}
+
+			for _, req := range resp.Requests {
+				results[i] = append(results[i], AccountRequest{Account: key, RequestElement: req})
+			}
+		}()
+	}
+	wg.Wait()
+
+	return slices.Concat(results...), errors.Join(errs...)
+}
+
+func (r *Registry) entry(key string) (registryEntry, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	entry, ok := r.accounts[key]
+	if !ok {
+		return entry, fmt.Errorf("%w: %s", ErrUnknownAccount, key)
+	}
+
+	return entry, nil
+}
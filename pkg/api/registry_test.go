@@ -0,0 +1,104 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/api"
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/delivery"
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+// redirect направляет запросы к API на тестовый сервер
+func redirect(server *httptest.Server) api.ClientFunc {
+	target, _ := url.Parse(server.URL)
+
+	return func(req *http.Request) (*http.Response, error) {
+		req.URL.Scheme, req.URL.Host = target.Scheme, target.Host
+		return server.Client().Do(req)
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.True(t, strings.HasSuffix(r.URL.Path, "/requests/info"))
+
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if token == "broken" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		json.NewEncoder(w).Encode(delivery.GetRequestsInfoResponse{
+			Requests: []delivery.RequestElement{{RequestID: token + "-1"}, {RequestID: token + "-2"}},
+		})
+	}))
+	defer server.Close()
+
+	registry := api.NewRegistry(redirect(server)).
+		Add("ip", api.Account{Environment: utils.Development, Token: "ip", PlatformStationID: "station-ip"}).
+		Add("llc", api.Account{Environment: utils.Development, Token: "llc", PlatformStationID: "station-llc"})
+
+	assert.Equal(t, []string{"ip", "llc"}, registry.Keys())
+
+	account, err := registry.Account("llc")
+	assert.NoError(t, err)
+	assert.Equal(t, "station-llc", account.PlatformStationID)
+
+	_, err = registry.Delivery("unknown")
+	assert.ErrorIs(t, err, api.ErrUnknownAccount)
+
+	requests, err := registry.GetRequestsInfo(context.Background(), time.Now().Add(-time.Hour), time.Now())
+	assert.NoError(t, err)
+	if assert.Len(t, requests, 4) {
+		for _, req := range requests {
+			assert.True(t, strings.HasPrefix(req.RequestID, req.Account+"-"))
+		}
+	}
+
+	registry.Add("broken", api.Account{Environment: utils.Development, Token: "broken"})
+	requests, err = registry.GetRequestsInfo(context.Background(), time.Now().Add(-time.Hour), time.Now())
+	assert.ErrorContains(t, err, "account broken")
+	assert.Len(t, requests, 4)
+}
+
+func TestRegistry_SourceStation(t *testing.T) {
+	sources := []map[string]any{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := struct {
+			Source map[string]any `json:"source"`
+		}{}
+		json.NewDecoder(r.Body).Decode(&body)
+		sources = append(sources, body.Source)
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	registry := api.NewRegistry(redirect(server)).
+		Add("ip", api.Account{Environment: utils.Development, Token: "ip", PlatformStationID: "station-ip"})
+
+	d, err := registry.Delivery("ip")
+	assert.NoError(t, err)
+
+	_, err = d.GetPredictedPrice(false, delivery.PredictPriceRequest{})
+	assert.NoError(t, err)
+	_, err = d.CreateRequest(delivery.CreateRequestRequest{})
+	assert.NoError(t, err)
+	_, err = d.CreateRequest(delivery.CreateRequestRequest{
+		Source: delivery.Source{PlatformStation: &delivery.PlatformStation{PlatformID: "other"}},
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []map[string]any{
+		{"platform_station_id": "station-ip"},
+		{"platform_station": map[string]any{"platform_id": "station-ip"}},
+		{"platform_station": map[string]any{"platform_id": "other"}},
+	}, sources)
+}
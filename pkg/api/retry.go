@@ -0,0 +1,127 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/utils"
+	"github.com/ReanSn0w/gokit/pkg/web"
+)
+
+// RetryOptions настройки повторного выполнения запросов
+type RetryOptions struct {
+	// Максимальное количество попыток, включая первую.
+	// Значение по умолчанию: 3
+	Attempts int
+
+	// Задержка перед первым повтором, удваивается с каждой попыткой.
+	// Значение по умолчанию: 500 миллисекунд
+	Delay time.Duration
+
+	// Определяет, нужно ли повторить запрос.
+	// По умолчанию повторяются сетевые ошибки, ответы 429 и 5xx
+	Retryable func(resp *http.Response, err error) bool
+
+	// Определяет, можно ли отправить запрос повторно.
+	// Значение по умолчанию: Idempotent
+	Replayable func(req *http.Request) bool
+
+	// Вызывается перед каждым повтором, например metrics.Collector.ObserveRetry
+	OnRetry func(endpoint string)
+}
+
+// Retry повторяет запросы, завершившиеся временной ошибкой
+func Retry(opts RetryOptions) Middleware {
+	if opts.Attempts <= 0 {
+		opts.Attempts = 3
+	}
+	if opts.Delay <= 0 {
+		opts.Delay = 500 * time.Millisecond
+	}
+	if opts.Retryable == nil {
+		opts.Retryable = isFailure
+	}
+	if opts.Replayable == nil {
+		opts.Replayable = Idempotent
+	}
+
+	return func(next web.HTTPClient) web.HTTPClient {
+		return ClientFunc(func(req *http.Request) (*http.Response, error) {
+			ctx := req.Context()
+			delay := opts.Delay
+
+			resp, err := next.Do(req)
+			if !opts.Replayable(req) {
+				return resp, err
+			}
+
+			for attempt := 1; attempt < opts.Attempts && opts.Retryable(resp, err); attempt++ {
+				if req.Body != nil && req.GetBody == nil {
+					break
+				}
+
+				if resp != nil {
+					resp.Body.Close()
+				}
+
+				if waitErr := wait(ctx, delay); waitErr != nil {
+					return nil, errors.Join(err, waitErr)
+				}
+				delay *= 2
+
+				if opts.OnRetry != nil {
+					opts.OnRetry(utils.Endpoint(ctx))
+				}
+
+				retry := req.Clone(ctx)
+				if req.GetBody != nil {
+					if retry.Body, err = req.GetBody(); err != nil {
+						return nil, err
+					}
+				}
+
+				resp, err = next.Do(retry)
+			}
+
+			return resp, err
+		})
+	}
+}
+
+// Методы API, повтор которых может создать дубль заказа,
+// оффера или заявки на редактирование
+var nonIdempotent = map[string]bool{
+	"/offers/create":                true,
+	"/offers/confirm":               true,
+	"/request/create":               true,
+	"/request/edit":                 true,
+	"/request/places/edit":          true,
+	"/request/items-instances/edit": true,
+}
+
+// Idempotent разрешает повтор запросов GET, HEAD и OPTIONS
+// и POST запросов к методам API, не создающим заказы и заявки на редактирование.
+// Запросы без пути метода API в контексте не повторяются
+func Idempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+
+	endpoint := utils.Endpoint(req.Context())
+	return endpoint != "" && !nonIdempotent[endpoint]
+}
+
+func wait(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
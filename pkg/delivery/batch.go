@@ -0,0 +1,148 @@
+package delivery
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+var (
+	ErrNoOffers = errors.New("no offers available")
+)
+
+// BatchOptions настройки пакетного создания заказов
+type BatchOptions struct {
+	// Количество одновременно выполняемых запросов.
+	// Значение по умолчанию: 1
+	Workers int
+
+	// Выбор оффера для подтверждения.
+	// По умолчанию подтверждается первый оффер из ответа
+	SelectOffer func(offers []OfferItem) (OfferItem, error)
+
+	// Вызывается после обработки каждого запроса.
+	// Вызовы выполняются последовательно
+	Progress func(done, total int, res BatchResult)
+}
+
+// BatchResult результат создания одного заказа из пакета
+type BatchResult struct {
+	Index     int    // Позиция запроса во входном срезе
+	RequestID string // Идентификатор созданного заказа
+	Err       error  // Ошибка создания заказа
+}
+
+// CreateRequests создает заказы пакетом через CreateRequest.
+// Результаты возвращаются в порядке входных запросов
+func CreateRequests(ctx context.Context, client DeliveryClient, reqs []CreateRequestRequest, opts BatchOptions) []BatchResult {
+	return runBatch(ctx, len(reqs), opts, func(ctx context.Context, i int) (string, error) {
+		resp, err := client.WithContext(ctx).CreateRequest(reqs[i])
+		if err != nil {
+			return "", err
+		}
+
+		return resp.RequestID, nil
+	})
+}
+
+// CreateOffers создает заказы пакетом через CreateOffer и ConfirmOffer.
+// Результаты возвращаются в порядке входных запросов
+func CreateOffers(ctx context.Context, client DeliveryClient, reqs []CreateOfferRequest, opts BatchOptions) []BatchResult {
+	selectOffer := opts.SelectOffer
+	if selectOffer == nil {
+		selectOffer = firstOffer
+	}
+
+	return runBatch(ctx, len(reqs), opts, func(ctx context.Context, i int) (string, error) {
+		client := client.WithContext(ctx)
+
+		offers, err := client.CreateOffer(reqs[i])
+		if err != nil {
+			return "", err
+		}
+
+		offer, err := selectOffer(offers.Offers)
+		if err != nil {
+			return "", err
+		}
+
+		resp, err := client.ConfirmOffer(offer.OfferID)
+		if err != nil {
+			return "", err
+		}
+
+		return resp.RequestID, nil
+	})
+}
+
+func firstOffer(offers []OfferItem) (OfferItem, error) {
+	if len(offers) == 0 {
+		return OfferItem{}, ErrNoOffers
+	}
+
+	return offers[0], nil
+}
+
+func runBatch(ctx context.Context, total int, opts BatchOptions, fn func(ctx context.Context, i int) (string, error)) []BatchResult {
+	results := make([]BatchResult, total)
+
+	workers := opts.Workers
+	if workers < 1 {
+		workers = 1
+	}
+	if workers > total {
+		workers = total
+	}
+
+	var (
+		mutex sync.Mutex
+		done  int
+	)
+
+	complete := func(res BatchResult) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		results[res.Index] = res
+		done++
+		if opts.Progress != nil {
+			opts.Progress(done, total, res)
+		}
+	}
+
+	jobs := make(chan int)
+	wg := sync.WaitGroup{}
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for i := range jobs {
+				res := BatchResult{Index: i, Err: ctx.Err()}
+				if res.Err == nil {
+					res.RequestID, res.Err = fn(ctx, i)
+				}
+
+				complete(res)
+			}
+		}()
+	}
+
+	next := 0
+feed:
+	for ; next < total; next++ {
+		select {
+		case jobs <- next:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	for ; next < total; next++ {
+		complete(BatchResult{Index: next, Err: ctx.Err()})
+	}
+
+	return results
+}
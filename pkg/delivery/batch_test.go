@@ -0,0 +1,116 @@
+package delivery_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/delivery"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDelivery_CreateRequests(t *testing.T) {
+	var (
+		active    int32
+		maxActive int32
+	)
+
+	d := newTestDelivery(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cur := atomic.AddInt32(&active, 1)
+		defer atomic.AddInt32(&active, -1)
+		for {
+			prev := atomic.LoadInt32(&maxActive)
+			if cur <= prev || atomic.CompareAndSwapInt32(&maxActive, prev, cur) {
+				break
+			}
+		}
+
+		req := delivery.CreateRequestRequest{}
+		json.NewDecoder(r.Body).Decode(&req)
+		if req.Info.OperatorRequestID == "bad" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"code":"validation_error"}`))
+			return
+		}
+
+		json.NewEncoder(w).Encode(delivery.CreateRequestResponse{RequestID: "req-" + req.Info.OperatorRequestID})
+	}))
+
+	ids := []string{"1", "2", "bad", "4", "5", "6"}
+	reqs := make([]delivery.CreateRequestRequest, len(ids))
+	for i, id := range ids {
+		reqs[i].Info.OperatorRequestID = id
+	}
+
+	progress := 0
+	results := delivery.CreateRequests(context.Background(), d, reqs, delivery.BatchOptions{
+		Workers: 2,
+		Progress: func(done, total int, res delivery.BatchResult) {
+			progress++
+			assert.Equal(t, progress, done)
+			assert.Equal(t, len(ids), total)
+		},
+	})
+
+	assert.Equal(t, len(ids), progress)
+	assert.LessOrEqual(t, atomic.LoadInt32(&maxActive), int32(2))
+	if assert.Len(t, results, len(ids)) {
+		for i, res := range results {
+			assert.Equal(t, i, res.Index)
+			if ids[i] == "bad" {
+				assert.Error(t, res.Err)
+				assert.Empty(t, res.RequestID)
+				continue
+			}
+
+			assert.NoError(t, res.Err)
+			assert.Equal(t, "req-"+ids[i], res.RequestID)
+		}
+	}
+}
+
+func TestDelivery_CreateOffers(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/offers/create", func(w http.ResponseWriter, r *http.Request) {
+		req := delivery.CreateOfferRequest{}
+		json.NewDecoder(r.Body).Decode(&req)
+		json.NewEncoder(w).Encode(delivery.CreateOfferResponse{
+			Offers: []delivery.OfferItem{{OfferID: "offer-" + req.Info.OperatorRequestID}},
+		})
+	})
+	mux.HandleFunc("/offers/confirm", func(w http.ResponseWriter, r *http.Request) {
+		req := map[string]string{}
+		json.NewDecoder(r.Body).Decode(&req)
+		json.NewEncoder(w).Encode(delivery.ConfirmOfferResponse{RequestID: "req-" + req["offer_id"]})
+	})
+	d := newTestDelivery(t, mux)
+
+	reqs := make([]delivery.CreateOfferRequest, 3)
+	for i := range reqs {
+		reqs[i].Info.OperatorRequestID = string(rune('a' + i))
+	}
+
+	results := delivery.CreateOffers(context.Background(), d, reqs, delivery.BatchOptions{Workers: 3})
+	for i, res := range results {
+		assert.NoError(t, res.Err)
+		assert.Equal(t, "req-offer-"+reqs[i].Info.OperatorRequestID, res.RequestID)
+	}
+}
+
+func TestDelivery_CreateRequestsCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	d := newTestDelivery(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cancel()
+		json.NewEncoder(w).Encode(delivery.CreateRequestResponse{RequestID: "req"})
+	}))
+
+	results := delivery.CreateRequests(ctx, d, make([]delivery.CreateRequestRequest, 5), delivery.BatchOptions{Workers: 1})
+	if assert.Len(t, results, 5) {
+		for _, res := range results[1:] {
+			assert.ErrorIs(t, res.Err, context.Canceled)
+		}
+	}
+}
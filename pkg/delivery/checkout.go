@@ -0,0 +1,283 @@
+package delivery
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"sync"
+	"time"
+)
+
+var (
+	ErrLocationNotFound = errors.New("location not found")
+)
+
+// CheckoutRequest корзина и адрес покупателя для расчета вариантов доставки
+type CheckoutRequest struct {
+	Source             Source        // Точка отправления заказа
+	Address            string        // Адрес покупателя
+	Position           *Position     // Координаты покупателя, см. Checkout
+	Places             []Place       // Грузоместа заказа
+	PaymentMethod      PaymentMethod // Способ оплаты
+	TotalAssessedPrice int64         // Суммарная оценочная стоимость посылок в копейках
+	ClientPrice        int64         // Сумма к оплате с получателя в копейках
+}
+
+// CheckoutOptions настройки расчета вариантов доставки
+type CheckoutOptions struct {
+	// Количество пунктов выдачи в результате.
+	// Значение по умолчанию: 5
+	Points int
+
+	// Ограничение времени выполнения каждого запроса к API.
+	// Значение по умолчанию: 10 секунд
+	Timeout time.Duration
+
+	// Фильтр пунктов выдачи, например CompatiblePoints.
+	// Применяется вместе с фильтром клиента WithPointsFilter
+	PointsFilter func(Point) bool
+}
+
+func (o CheckoutOptions) withDefaults() CheckoutOptions {
+	if o.Points <= 0 {
+		o.Points = 5
+	}
+	if o.Timeout <= 0 {
+		o.Timeout = 10 * time.Second
+	}
+	return o
+}
+
+// CheckoutResult варианты доставки заказа
+type CheckoutResult struct {
+	Courier *DeliveryOption  // Курьерская доставка, nil если недоступна
+	Pickup  []DeliveryOption // Доставка в пункты выдачи, ближайшие в начале
+}
+
+// DeliveryOption вариант доставки для отображения покупателю
+type DeliveryOption struct {
+	Policy    LastMilePolicy // Способ доставки
+	Point     *Point         // Пункт выдачи, для доставки в пункт выдачи
+	Distance  float64        // Расстояние до пункта выдачи в метрах, если известны координаты покупателя
+	Intervals []Offer        // Доступные интервалы доставки
+	Price     float64        // Стоимость доставки
+	Pricing   string         // Стоимость доставки в формате API, например "299.5 RUB"
+}
+
+// Checkout рассчитывает варианты курьерской доставки и доставки в ближайшие пункты выдачи.
+// Запросы к API выполняются параллельно. Если часть запросов завершилась ошибкой,
+// возвращаются остальные варианты вместе с объединенной ошибкой.
+// Вариант со стоимостью, которую не удалось разобрать, считается ошибкой ErrInvalidPrice.
+//
+// Пункты выдачи ищутся в населенном пункте адреса покупателя.
+// API не возвращает координаты адреса, поэтому ближайшие пункты выбираются
+// только по req.Position, который нужно получить внешним геокодером.
+// Без координат пункты берутся в порядке ответа API, а Distance равно нулю
+func Checkout(ctx context.Context, client DeliveryClient, req CheckoutRequest, opts CheckoutOptions) (*CheckoutResult, error) {
+	opts = opts.withDefaults()
+
+	var (
+		wg     sync.WaitGroup
+		res    = &CheckoutResult{}
+		errsMu sync.Mutex
+		errs   []error
+	)
+
+	fail := func(err error) {
+		errsMu.Lock()
+		defer errsMu.Unlock()
+		errs = append(errs, err)
+	}
+
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+
+		option, err := checkoutOption(ctx, client, req, opts, LMP_TimeInterval, Destination{
+			Type:    LMP_TimeInterval.DestinationType(),
+			Address: req.Address,
+		})
+		if err != nil {
+			fail(fmt.Errorf("courier: %w", err))
+			return
+		}
+		res.Courier = option
+	}()
+	go func() {
+		defer wg.Done()
+
+		pickup, err := checkoutPickup(ctx, client, req, opts)
+		if err != nil {
+			fail(fmt.Errorf("pickup: %w", err))
+		}
+		res.Pickup = pickup
+	}()
+	wg.Wait()
+
+	return res, errors.Join(errs...)
+}
+
+// checkoutPickup рассчитывает варианты доставки в ближайшие пункты выдачи
+func checkoutPickup(ctx context.Context, client DeliveryClient, req CheckoutRequest, opts CheckoutOptions) ([]DeliveryOption, error) {
+	points, err := checkoutPoints(ctx, client, req, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		wg      sync.WaitGroup
+		options = make([]*DeliveryOption, len(points))
+		errs    = make([]error, len(points))
+	)
+
+	for i, point := range points {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			option, err := checkoutOption(ctx, client, req, opts, LMP_SelfPickup, Destination{
+				Type:              LMP_SelfPickup.DestinationType(),
+				PlatformStationID: point.ID,
+			})
+			if err != nil {
+				errs[i] = fmt.Errorf("point %v: %w", point.ID, err)
+				return
+			}
+
+			option.Point = &point
+			if req.Position != nil {
+				option.Distance = distance(*req.Position, point.Position)
+			}
+			options[i] = option
+		}()
+	}
+	wg.Wait()
+
+	res := []DeliveryOption{}
+	for _, option := range options {
+		if option != nil {
+			res = append(res, *option)
+		}
+	}
+
+	return res, errors.Join(errs...)
+}
+
+// checkoutPoints возвращает ближайшие к покупателю пункты выдачи
+func checkoutPoints(ctx context.Context, client DeliveryClient, req CheckoutRequest, opts CheckoutOptions) ([]Point, error) {
+	callCtx, cancel := context.WithTimeout(ctx, opts.Timeout)
+	location, err := client.WithContext(callCtx).GetLocationID(req.Address)
+	cancel()
+	if err != nil {
+		return nil, err
+	}
+	if len(location.Variants) == 0 {
+		return nil, fmt.Errorf("%w: %v", ErrLocationNotFound, req.Address)
+	}
+
+	pointsReq := DeliveryPointsRequest{GeoID: location.Variants[0].GeoID}
+	if req.PaymentMethod != "" && req.PaymentMethod != PM_AlreadyPaid {
+		pointsReq.PaymentMethod = req.PaymentMethod
+	}
+
+	callCtx, cancel = context.WithTimeout(ctx, opts.Timeout)
+	resp, err := client.WithContext(callCtx).GetDeliveryPoints(pointsReq)
+	cancel()
+	if err != nil {
+		return nil, err
+	}
+
+	points := resp.Points
+	if opts.PointsFilter != nil {
+		points = slices.DeleteFunc(points, func(p Point) bool { return !opts.PointsFilter(p) })
+	}
+
+	if req.Position != nil {
+		slices.SortStableFunc(points, func(a, b Point) int {
+			return cmp.Compare(distance(*req.Position, a.Position), distance(*req.Position, b.Position))
+		})
+	}
+
+	return points[:min(len(points), opts.Points)], nil
+}
+
+// checkoutOption параллельно запрашивает интервалы и стоимость доставки в destination
+func checkoutOption(ctx context.Context, client DeliveryClient, req CheckoutRequest, opts CheckoutOptions, policy LastMilePolicy, destination Destination) (*DeliveryOption, error) {
+	var (
+		wg        sync.WaitGroup
+		intervals *DeliveryIntervalsResponse
+		price     *PredictPriceResponse
+		errs      = make([]error, 2)
+	)
+
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+
+		ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+
+		intervals, errs[0] = client.WithContext(ctx).GetDeliveryIntervals(false, policy, DeliveryIntervalsRequest{
+			Source:      req.Source,
+			Destination: destination,
+			Places:      req.Places,
+		})
+	}()
+	go func() {
+		defer wg.Done()
+
+		ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+
+		price, errs[1] = client.WithContext(ctx).GetPredictedPrice(false, PredictPriceRequest{
+			Source:             req.Source,
+			Destination:        destination,
+			PaymentMethod:      req.PaymentMethod,
+			Places:             req.Places,
+			Tariff:             policy,
+			TotalWeight:        totalWeight(req.Places),
+			TotalAssessedPrice: req.TotalAssessedPrice,
+			ClientPrice:        req.ClientPrice,
+		})
+	}()
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	amount, err := parsePrice(price.PricingTotal)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DeliveryOption{
+		Policy:    policy,
+		Intervals: intervals.Offers,
+		Price:     amount,
+		Pricing:   price.PricingTotal,
+	}, nil
+}
+
+func totalWeight(places []Place) int64 {
+	var weight float64
+	for _, place := range places {
+		weight += place.PhysicalDims.WeightGross
+	}
+	return int64(weight)
+}
+
+// distance возвращает расстояние между точками в метрах
+func distance(a, b Position) float64 {
+	const earthRadius = 6371000
+
+	lat1, lat2 := a.Latitude*math.Pi/180, b.Latitude*math.Pi/180
+	dLat := lat2 - lat1
+	dLon := (b.Longitude - a.Longitude) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadius * math.Asin(math.Sqrt(h))
+}
@@ -0,0 +1,117 @@
+package delivery_test
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/delivery"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDelivery_Checkout(t *testing.T) {
+	now := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	points := []delivery.Point{
+		{ID: "far", Position: delivery.Position{Latitude: 55.80, Longitude: 37.60}},
+		{ID: "near", Position: delivery.Position{Latitude: 55.751, Longitude: 37.601}},
+		{ID: "broken", Position: delivery.Position{Latitude: 55.76, Longitude: 37.60}},
+	}
+
+	cases := []struct {
+		Name    string
+		Slow    bool
+		Courier bool
+		Price   string
+		Filter  func(delivery.Point) bool
+		Pickup  []string
+		Err     bool
+	}{
+		{Name: "partial", Courier: true, Pickup: []string{"near"}, Err: true},
+		{Name: "courier timeout", Slow: true, Pickup: []string{"near"}, Err: true},
+		{Name: "filters", Courier: true, Filter: func(p delivery.Point) bool { return p.ID != "broken" }, Pickup: []string{"near"}},
+		{Name: "invalid price", Courier: true, Price: "n/a", Pickup: []string{}, Err: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			mux.HandleFunc("/location/detect", func(w http.ResponseWriter, r *http.Request) {
+				json.NewEncoder(w).Encode(delivery.LocationIDResponse{Variants: []delivery.LocationDetectedVariant{{GeoID: 213}}})
+			})
+			mux.HandleFunc("/pickup-points/list", func(w http.ResponseWriter, r *http.Request) {
+				req := delivery.DeliveryPointsRequest{}
+				json.NewDecoder(r.Body).Decode(&req)
+				assert.Equal(t, int64(213), req.GeoID)
+				assert.Equal(t, delivery.PM_CardOnReceiot, req.PaymentMethod)
+
+				json.NewEncoder(w).Encode(delivery.DeliveryPointsResponse{Points: points})
+			})
+			mux.HandleFunc("/offers/info", func(w http.ResponseWriter, r *http.Request) {
+				if c.Slow && r.URL.Query().Get("last_mile_policy") == string(delivery.LMP_TimeInterval) {
+					select {
+					case <-r.Context().Done():
+					case <-time.After(300 * time.Millisecond):
+					}
+					return
+				}
+
+				json.NewEncoder(w).Encode(delivery.DeliveryIntervalsResponse{Offers: []delivery.Offer{{From: now, To: now.Add(time.Hour)}}})
+			})
+			mux.HandleFunc("/pricing-calculator", func(w http.ResponseWriter, r *http.Request) {
+				req := delivery.PredictPriceRequest{}
+				json.NewDecoder(r.Body).Decode(&req)
+				assert.Equal(t, int64(1500), req.TotalWeight)
+
+				if req.Destination.PlatformStationID == "broken" {
+					w.WriteHeader(http.StatusInternalServerError)
+					w.Write([]byte(`{"code":"internal"}`))
+					return
+				}
+
+				price := "300 RUB"
+				if req.Tariff == delivery.LMP_SelfPickup {
+					price = cmp.Or(c.Price, "150.5 RUB")
+				}
+				json.NewEncoder(w).Encode(delivery.PredictPriceResponse{PricingTotal: price})
+			})
+
+			// Фильтр клиента применяется вместе с CheckoutOptions.PointsFilter
+			d := newTestDelivery(t, mux).WithPointsFilter(func(p delivery.Point) bool { return p.ID != "far" })
+
+			res, err := delivery.Checkout(context.Background(), d, delivery.CheckoutRequest{
+				Address:       "Москва, Тверская 1",
+				Position:      &delivery.Position{Latitude: 55.75, Longitude: 37.60},
+				Places:        []delivery.Place{place("p1", 10, 10, 10, 1000), place("p2", 10, 10, 10, 500)},
+				PaymentMethod: delivery.PM_CardOnReceiot,
+			}, delivery.CheckoutOptions{Points: 3, Timeout: 200 * time.Millisecond, PointsFilter: c.Filter})
+
+			assert.Equal(t, c.Err, err != nil)
+			if c.Price != "" {
+				assert.ErrorIs(t, err, delivery.ErrInvalidPrice)
+			}
+
+			if c.Courier && assert.NotNil(t, res.Courier) {
+				assert.Equal(t, delivery.LMP_TimeInterval, res.Courier.Policy)
+				assert.Equal(t, 300.0, res.Courier.Price)
+				assert.Len(t, res.Courier.Intervals, 1)
+			}
+			if !c.Courier {
+				assert.Nil(t, res.Courier)
+			}
+
+			ids := []string{}
+			for _, option := range res.Pickup {
+				ids = append(ids, option.Point.ID)
+				assert.Equal(t, 150.5, option.Price)
+				assert.Equal(t, "150.5 RUB", option.Pricing)
+			}
+			assert.Equal(t, c.Pickup, ids)
+			if len(res.Pickup) > 0 {
+				assert.Less(t, res.Pickup[0].Distance, 200.0)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package delivery
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+//go:generate mockery --name DeliveryClient --output mocks --outpkg mocks --with-expecter
+
+var _ DeliveryClient = (*Delivery)(nil)
+
+// DeliveryClient описывает методы API доставки.
+// Позволяет подменять клиент в тестах сервисов
+// сгенерированным mocks.DeliveryClient или deliverytest.Fake.
+//
+// Сценарии, составленные из нескольких вызовов API (CreateOfferSet, Reschedule,
+// SwitchToPickup, Checkout и другие), реализованы функциями, принимающими DeliveryClient
+type DeliveryClient interface {
+	WithContext(ctx context.Context) DeliveryClient
+	WithOversizedDetection(limits OversizedLimits) DeliveryClient
+	WithPointsFilter(filter func(Point) bool) DeliveryClient
+	WithUnixTime(enabled bool) DeliveryClient
+	WithSourceStation(stationID string) DeliveryClient
+
+	GetPredictedPrice(isOversized bool, req PredictPriceRequest) (*PredictPriceResponse, error)
+	GetDeliveryIntervals(isOversized bool, lastMilePolicy LastMilePolicy, req DeliveryIntervalsRequest) (*DeliveryIntervalsResponse, error)
+	GetLocationID(address string) (*LocationIDResponse, error)
+	GetDeliveryPoints(req DeliveryPointsRequest) (*DeliveryPointsResponse, error)
+
+	CreateOffer(req CreateOfferRequest) (*CreateOfferResponse, error)
+	ConfirmOffer(offerID string) (*ConfirmOfferResponse, error)
+	CreateRequest(req CreateRequestRequest) (*CreateRequestResponse, error)
+
+	GetRequestInfo(requestID string, slim bool) (*GetRequestInfoResponse, error)
+	GetRequestsInfo(from, to time.Time, requestsIds ...string) (*GetRequestsInfoResponse, error)
+	GetRequestActualInfo(requestID string) (*GetRequestActualInfoResponse, error)
+	GetRequestHistory(requestID string) (*GetRequestHistoryResponse, error)
+	CancelRequest(requestID string) (*CancelRequestResponse, error)
+	CancelRequestWithReason(requestID string, reason Reason) (*CancelRequestResponse, error)
+
+	EditRequestInfo(req EditRequestInfoRequest) (*EditRequestInfoResponse, error)
+	GetRequestRedeliveryOptions(req GetRequestRedeliveryOptionsRequest) (*GetRequestRedeliveryOptionsResponse, error)
+	EditRequestPlaces(req EditRequestPlacesRequest) (*EditRequestPlacesResponse, error)
+	EditRequestItems(req EditRequestItemsRequest) (*EditRequestItemsResponse, error)
+	GetEditRequestStatus(taskID string) (*GetEditRequestStatusResponse, error)
+
+	GenerateRequestLabels(req GenerateRequestLabelsRequest) (io.ReadCloser, error)
+	GetRequestHandoverAct(requestIds ...string) (io.ReadCloser, error)
+}
+
+// direct возвращает клиент без автоматического определения КГТ,
+// настроенного через WithOversizedDetection.
+// Используется сценариями для внутренних запросов
+func direct(client DeliveryClient) DeliveryClient {
+	return client.WithOversizedDetection(OversizedLimits{})
+}
+
+// unfiltered возвращает клиент без фильтра точек выдачи,
+// настроенного через WithPointsFilter.
+// Используется для проверки существования выбранной точки
+func unfiltered(client DeliveryClient) DeliveryClient {
+	return client.WithPointsFilter(nil)
+}
@@ -0,0 +1,125 @@
+package delivery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// PriceMatrix варианты параметров для сравнения стоимости доставки
+type PriceMatrix struct {
+	// Тарифы. По умолчанию LMP_TimeInterval и LMP_SelfPickup
+	Tariffs []LastMilePolicy
+
+	// Способы оплаты. По умолчанию способ оплаты из запроса
+	PaymentMethods []PaymentMethod
+
+	// Значения флага КГТ. По умолчанию false и true
+	Oversized []bool
+
+	// Максимальное количество одновременных запросов к API.
+	// Значение по умолчанию: 4
+	Workers int
+}
+
+func (m PriceMatrix) withDefaults(req PredictPriceRequest) PriceMatrix {
+	if len(m.Tariffs) == 0 {
+		m.Tariffs = []LastMilePolicy{LMP_TimeInterval, LMP_SelfPickup}
+	}
+	if len(m.PaymentMethods) == 0 {
+		m.PaymentMethods = []PaymentMethod{req.PaymentMethod}
+	}
+	if len(m.Oversized) == 0 {
+		m.Oversized = []bool{false, true}
+	}
+	if m.Workers <= 0 {
+		m.Workers = 4
+	}
+	return m
+}
+
+// PriceComparison стоимость доставки для одного сочетания параметров.
+// Суммы указаны в рублях с НДС
+type PriceComparison struct {
+	Tariff        LastMilePolicy
+	PaymentMethod PaymentMethod
+	IsOversized   bool
+
+	Total             float64 // Суммарная стоимость доставки
+	Pricing           float64 // Стоимость доставки и страхования
+	Commission        float64 // Комиссия за прием наложенного платежа
+	CommissionPercent float64 // Комиссия за прием наложенного платежа в процентах
+
+	Err error // Ошибка расчета стоимости или разбора сумм ответа
+}
+
+// ComparePrices параллельно, не более matrix.Workers запросов одновременно, рассчитывает стоимость доставки для всех сочетаний
+// тарифа, способа оплаты и флага КГТ. Результаты возвращаются в порядке
+// перебора: тариф, затем способ оплаты, затем флаг КГТ.
+// Автоматическое определение КГТ при сравнении не применяется
+func ComparePrices(ctx context.Context, client DeliveryClient, req PredictPriceRequest, matrix PriceMatrix) []PriceComparison {
+	matrix = matrix.withDefaults(req)
+
+	client = direct(client).WithContext(ctx)
+
+	res := []PriceComparison{}
+	for _, tariff := range matrix.Tariffs {
+		for _, method := range matrix.PaymentMethods {
+			for _, oversized := range matrix.Oversized {
+				res = append(res, PriceComparison{Tariff: tariff, PaymentMethod: method, IsOversized: oversized})
+			}
+		}
+	}
+
+	var (
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, matrix.Workers)
+	)
+	for i := range res {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			row := &res[i]
+			req := req
+			req.Tariff = row.Tariff
+			req.PaymentMethod = row.PaymentMethod
+
+			price, err := client.GetPredictedPrice(row.IsOversized, req)
+			if err != nil {
+				row.Err = err
+				return
+			}
+
+			var errs [4]error
+			row.Total, errs[0] = parseAmount("pricing_total", price.PricingTotal)
+			row.Pricing, errs[1] = parseAmount("pricing", price.Pricing)
+			row.Commission, errs[2] = parseAmount("pricing_commission_on_delivery_payment_amount", price.PricingCommissionOnDeliveryPaymentAmount)
+			row.CommissionPercent, errs[3] = parseAmount("pricing_commission_on_delivery_payment", price.PricingCommissionOnDeliveryPayment)
+			row.Err = errors.Join(errs[:]...)
+		}()
+	}
+	wg.Wait()
+
+	return res
+}
+
+// parseAmount разбирает сумму или процент поля field в формате API.
+// Пустое значение считается нулем, некорректное возвращает ErrInvalidPrice
+func parseAmount(field, value string) (float64, error) {
+	value = strings.TrimSuffix(strings.TrimSpace(value), "%")
+	if value == "" {
+		return 0, nil
+	}
+
+	amount, err := parsePrice(value)
+	if err != nil {
+		return 0, fmt.Errorf("%v: %w", field, err)
+	}
+	return amount, nil
+}
@@ -0,0 +1,114 @@
+package delivery_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/delivery"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDelivery_ComparePrices(t *testing.T) {
+	var (
+		mutex        sync.Mutex
+		active, peak int
+	)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/pricing-calculator", func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		active++
+		peak = max(peak, active)
+		mutex.Unlock()
+
+		defer func() {
+			mutex.Lock()
+			active--
+			mutex.Unlock()
+		}()
+		time.Sleep(5 * time.Millisecond)
+
+		req := delivery.PredictPriceRequest{}
+		json.NewDecoder(r.Body).Decode(&req)
+
+		if req.ClientPrice > 0 {
+			json.NewEncoder(w).Encode(delivery.PredictPriceResponse{PricingTotal: "n/a", Pricing: "100 RUB"})
+			return
+		}
+
+		if req.PaymentMethod == delivery.PM_CashOnDelivery && req.Tariff == delivery.LMP_SelfPickup {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"code":"payment_not_supported"}`))
+			return
+		}
+
+		total := 200.0
+		if req.Tariff == delivery.LMP_TimeInterval {
+			total = 300
+		}
+		if r.URL.Query().Get("is_oversized") == "true" {
+			total *= 2
+		}
+
+		resp := delivery.PredictPriceResponse{
+			PricingTotal: fmt.Sprintf("%v RUB", total),
+			Pricing:      fmt.Sprintf("%v RUB", total),
+		}
+		if req.PaymentMethod == delivery.PM_CashOnDelivery {
+			resp.PricingTotal = fmt.Sprintf("%v RUB", total+10.5)
+			resp.PricingCommissionOnDeliveryPaymentAmount = "10.5 RUB"
+			resp.PricingCommissionOnDeliveryPayment = "2.2%"
+		}
+		json.NewEncoder(w).Encode(resp)
+	})
+
+	d := newTestDelivery(t, mux).WithOversizedDetection(delivery.DefaultOversizedLimits)
+
+	rows := delivery.ComparePrices(context.Background(), d, delivery.PredictPriceRequest{
+		Places: []delivery.Place{place("heavy", 40, 30, 30, 35000)},
+	}, delivery.PriceMatrix{
+		PaymentMethods: []delivery.PaymentMethod{delivery.PM_AlreadyPaid, delivery.PM_CashOnDelivery},
+		Workers:        2,
+	})
+
+	if !assert.Len(t, rows, 8) {
+		return
+	}
+
+	assert.Equal(t, delivery.PriceComparison{
+		Tariff:        delivery.LMP_TimeInterval,
+		PaymentMethod: delivery.PM_AlreadyPaid,
+		Total:         300,
+		Pricing:       300,
+	}, rows[0])
+
+	assert.Equal(t, delivery.PriceComparison{
+		Tariff:            delivery.LMP_TimeInterval,
+		PaymentMethod:     delivery.PM_CashOnDelivery,
+		IsOversized:       true,
+		Total:             610.5,
+		Pricing:           600,
+		Commission:        10.5,
+		CommissionPercent: 2.2,
+	}, rows[3])
+
+	assert.Equal(t, 400.0, rows[5].Total)
+	assert.Error(t, rows[6].Err)
+	assert.Error(t, rows[7].Err)
+	assert.LessOrEqual(t, peak, 2)
+
+	rows = delivery.ComparePrices(context.Background(), d, delivery.PredictPriceRequest{ClientPrice: 1}, delivery.PriceMatrix{
+		Tariffs:   []delivery.LastMilePolicy{delivery.LMP_SelfPickup},
+		Oversized: []bool{false},
+	})
+	if assert.Len(t, rows, 1) {
+		assert.ErrorIs(t, rows[0].Err, delivery.ErrInvalidPrice)
+		assert.ErrorContains(t, rows[0].Err, "pricing_total")
+		assert.Equal(t, 100.0, rows[0].Pricing)
+	}
+}
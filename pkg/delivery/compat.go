@@ -0,0 +1,133 @@
+package delivery
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+var (
+	ErrPointIncompatible = errors.New("places do not fit delivery point")
+)
+
+// DefaultPointLimits ограничения точек выдачи по типам.
+//
+// Значения приблизительные и не возвращаются API: для постаматов указаны
+// размеры типовых ячеек S, M и L, для ПВЗ — ограничения DefaultOversizedLimits,
+// для почтовых отделений — ограничения посылки. Перед использованием
+// сверьте их с условиями договора и при необходимости передайте
+// в CompatiblePoints собственную таблицу, например копию DefaultPointLimits
+// с измененными значениями
+var DefaultPointLimits = PointLimitsTable{
+	PST_Terminal: {
+		MaxWeight: 15000,
+		Cells: []Cell{
+			{Dx: 64, Dy: 8, Dz: 38},  // S
+			{Dx: 64, Dy: 19, Dz: 38}, // M
+			{Dx: 64, Dy: 40, Dz: 38}, // L
+		},
+	},
+	PST_PickupPoint: {
+		MaxWeight:  DefaultOversizedLimits.MaxWeight,
+		MaxSide:    DefaultOversizedLimits.MaxSide,
+		MaxSideSum: DefaultOversizedLimits.MaxSideSum,
+	},
+	PST_PostOffice: {
+		MaxWeight:  20000,
+		MaxSide:    150,
+		MaxSideSum: 300,
+	},
+}
+
+// PointLimitsTable ограничения точек выдачи по типам.
+// Точки, тип которых отсутствует в таблице, принимают любые грузоместа
+type PointLimitsTable map[PickupStationType]PointLimits
+
+// PointLimits ограничения грузоместа для точки выдачи.
+// Нулевое значение ограничения не проверяется
+type PointLimits struct {
+	MaxWeight  float64 // Максимальный вес брутто, граммы
+	MaxSide    float64 // Максимальная длина стороны, сантиметры
+	MaxSideSum float64 // Максимальная сумма трех измерений, сантиметры
+
+	// Ячейки постамата.
+	// Если заданы, каждое грузоместо должно помещаться хотя бы в одну ячейку
+	Cells []Cell
+}
+
+// Cell размер ячейки постамата, сантиметры
+type Cell struct {
+	Dx float64
+	Dy float64
+	Dz float64
+}
+
+// Check проверяет, что грузоместа можно доставить в точку выдачи.
+// Возвращает ошибку ErrPointIncompatible с описанием нарушенных ограничений
+func (t PointLimitsTable) Check(point Point, places []Place) error {
+	limits, ok := t[PickupStationType(point.Type)]
+	if !ok {
+		return nil
+	}
+
+	reasons := []string{}
+	for _, place := range places {
+		for _, reason := range limits.check(place) {
+			reasons = append(reasons, place.Barcode+": "+reason)
+		}
+	}
+
+	if len(reasons) > 0 {
+		return fmt.Errorf("%w: %v: %v", ErrPointIncompatible, point.ID, strings.Join(reasons, "; "))
+	}
+
+	return nil
+}
+
+func (l PointLimits) check(place Place) []string {
+	reasons := OversizedLimits{
+		MaxWeight:  l.MaxWeight,
+		MaxSide:    l.MaxSide,
+		MaxSideSum: l.MaxSideSum,
+	}.Classify([]Place{place}).Places[0].Reasons
+
+	if len(l.Cells) > 0 && !slices.ContainsFunc(l.Cells, func(c Cell) bool { return c.fits(place.PhysicalDims) }) {
+		reasons = append(reasons, "does not fit any locker cell")
+	}
+
+	return reasons
+}
+
+// fits проверяет, помещается ли грузоместо в ячейку с учетом поворота
+func (c Cell) fits(dims PhysicalDims) bool {
+	cell := []float64{c.Dx, c.Dy, c.Dz}
+	place := []float64{dims.Dx, dims.Dy, dims.Dz}
+	slices.Sort(cell)
+	slices.Sort(place)
+
+	for i := range cell {
+		if place[i] > cell[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// CompatiblePoints возвращает фильтр точек выдачи,
+// в которые можно доставить грузоместа places
+func CompatiblePoints(places []Place, limits PointLimitsTable) func(Point) bool {
+	return func(point Point) bool {
+		return limits.Check(point, places) == nil
+	}
+}
+
+// WithPointsFilter возвращает копию клиента, которая исключает
+// из ответа GetDeliveryPoints точки, не прошедшие фильтр.
+// Фильтр учитывается при поиске точек в Checkout вместе с CheckoutOptions.PointsFilter.
+// Проверка выбранной точки в SwitchToPickup и ChangePickupPoint выполняется без него
+func (d *Delivery) WithPointsFilter(filter func(Point) bool) DeliveryClient {
+	c := *d
+	c.filter = filter
+	return &c
+}
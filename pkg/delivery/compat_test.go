@@ -0,0 +1,70 @@
+package delivery_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/delivery"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPointLimitsTable_Check(t *testing.T) {
+	locker := delivery.Point{ID: "locker", Type: string(delivery.PST_Terminal)}
+	pvz := delivery.Point{ID: "pvz", Type: string(delivery.PST_PickupPoint)}
+	unknown := delivery.Point{ID: "unknown", Type: "warehouse"}
+
+	small := place("small", 30, 10, 30, 2000)
+	large := place("large", 50, 50, 50, 10000)
+	heavy := place("heavy", 30, 10, 30, 20000)
+
+	cases := []struct {
+		Name   string
+		Point  delivery.Point
+		Places []delivery.Place
+		Err    string
+	}{
+		{Name: "locker fits", Point: locker, Places: []delivery.Place{small}},
+		{Name: "locker cell", Point: locker, Places: []delivery.Place{small, large}, Err: "places do not fit delivery point: locker: large: does not fit any locker cell"},
+		{Name: "locker weight", Point: locker, Places: []delivery.Place{heavy}, Err: "places do not fit delivery point: locker: heavy: weight 20000 g exceeds 15000 g"},
+		{Name: "pickup point", Point: pvz, Places: []delivery.Place{large, heavy}},
+		{Name: "unknown type", Point: unknown, Places: []delivery.Place{place("huge", 300, 300, 300, 100000)}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			err := delivery.DefaultPointLimits.Check(c.Point, c.Places)
+			if c.Err == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.ErrorIs(t, err, delivery.ErrPointIncompatible)
+			assert.EqualError(t, err, c.Err)
+		})
+	}
+}
+
+func TestDelivery_WithPointsFilter(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/pickup-points/list", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(delivery.DeliveryPointsResponse{Points: []delivery.Point{
+			{ID: "locker", Type: string(delivery.PST_Terminal)},
+			{ID: "pvz", Type: string(delivery.PST_PickupPoint)},
+		}})
+	})
+	d := newTestDelivery(t, mux)
+
+	places := []delivery.Place{place("large", 50, 50, 50, 10000)}
+
+	resp, err := d.GetDeliveryPoints(delivery.DeliveryPointsRequest{})
+	if assert.NoError(t, err) {
+		assert.Len(t, resp.Points, 2)
+	}
+
+	resp, err = d.WithPointsFilter(delivery.CompatiblePoints(places, delivery.DefaultPointLimits)).
+		GetDeliveryPoints(delivery.DeliveryPointsRequest{})
+	if assert.NoError(t, err) && assert.Len(t, resp.Points, 1) {
+		assert.Equal(t, "pvz", resp.Points[0].ID)
+	}
+}
@@ -0,0 +1,102 @@
+package delivery
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+var (
+	ErrPointNotFound             = errors.New("delivery point not found")
+	ErrPaymentMethodNotSupported = errors.New("payment method is not supported by delivery point")
+)
+
+// SwitchToPickup переводит заказ с курьерской доставки
+// на доставку в пункт выдачи pointID
+func SwitchToPickup(client DeliveryClient, requestID, pointID string) (*EditRequestInfoResponse, error) {
+	return switchToPoint(client, requestID, pointID, A_UpdateCourierToPickup)
+}
+
+// ChangePickupPoint меняет пункт выдачи заказа на pointID
+func ChangePickupPoint(client DeliveryClient, requestID, pointID string) (*EditRequestInfoResponse, error) {
+	return switchToPoint(client, requestID, pointID, A_UpdatePickupToPickup)
+}
+
+// SwitchToCourier переводит заказ из пункта выдачи
+// на курьерскую доставку по адресу location в интервал interval
+func SwitchToCourier(client DeliveryClient, requestID string, location CustomLocation, interval IntervalUTC) (*EditRequestInfoResponse, error) {
+	info, err := client.GetRequestInfo(requestID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkActions(requestID, info.Request.AvailableActions, A_UpdatePickupToCourier)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.EditRequestInfo(editRequest(requestID, info.Request, LMP_TimeInterval, Destination{
+		Type:           LMP_TimeInterval.DestinationType(),
+		CustomLocation: &location,
+		IntervalUTC:    &interval,
+	}))
+}
+
+func switchToPoint(client DeliveryClient, requestID, pointID string, action Action) (*EditRequestInfoResponse, error) {
+	info, err := client.GetRequestInfo(requestID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkActions(requestID, info.Request.AvailableActions, action)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkPoint(client, pointID, info.Request.BillingInfo.PaymentMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.EditRequestInfo(editRequest(requestID, info.Request, LMP_SelfPickup, Destination{
+		Type:            LMP_SelfPickup.DestinationType(),
+		PlatformStation: &PlatformStation{PlatformID: pointID},
+	}))
+}
+
+// checkPoint проверяет, что пункт выдачи принимает оплату заказа способом method.
+// Для оплаченных заказов проверяется только наличие пункта.
+// Фильтр точек клиента при проверке не применяется
+func checkPoint(client DeliveryClient, pointID string, method PaymentMethod) error {
+	resp, err := unfiltered(client).GetDeliveryPoints(DeliveryPointsRequest{PickupPointIDS: []string{pointID}})
+	if err != nil {
+		return err
+	}
+
+	i := slices.IndexFunc(resp.Points, func(p Point) bool { return p.ID == pointID })
+	if i < 0 {
+		return fmt.Errorf("%w: %v", ErrPointNotFound, pointID)
+	}
+
+	if method == "" || method == PM_AlreadyPaid {
+		return nil
+	}
+
+	if !slices.Contains(resp.Points[i].PaymentMethods, string(method)) {
+		return fmt.Errorf("%w: %v does not accept %v", ErrPaymentMethodNotSupported, pointID, method)
+	}
+
+	return nil
+}
+
+// editRequest создает запрос на изменение места получения заказа
+// с сохранением получателя и грузомест
+func editRequest(requestID string, current RequestInfo, policy LastMilePolicy, destination Destination) EditRequestInfoRequest {
+	return EditRequestInfoRequest{
+		RequestID:      requestID,
+		RecipientInfo:  current.RecipientInfo,
+		Destination:    destination,
+		LastMilePolicy: string(policy),
+		Places:         placeElements(current.Places),
+	}
+}
@@ -0,0 +1,157 @@
+package delivery_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/delivery"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDelivery_SwitchToPickup(t *testing.T) {
+	points := []delivery.Point{
+		{ID: "cash", PaymentMethods: []string{string(delivery.PM_AlreadyPaid), string(delivery.PM_CashOnDelivery)}},
+		{ID: "prepaid", PaymentMethods: []string{string(delivery.PM_AlreadyPaid)}},
+	}
+
+	cases := []struct {
+		Name    string
+		Policy  delivery.LastMilePolicy
+		Payment delivery.PaymentMethod
+		Actions delivery.AvailableActions
+		Point   string
+		Change  bool
+		Err     error
+	}{
+		{
+			Name:    "switch",
+			Policy:  delivery.LMP_TimeInterval,
+			Payment: delivery.PM_CashOnDelivery,
+			Actions: delivery.AvailableActions{UpdateCourierToPickupAvailable: true},
+			Point:   "cash",
+		},
+		{
+			Name:    "switch not available",
+			Policy:  delivery.LMP_TimeInterval,
+			Actions: delivery.AvailableActions{UpdatePickupToPickupAvailable: true},
+			Point:   "cash",
+			Err:     delivery.ErrActionNotAvailable,
+		},
+		{
+			Name:    "change point",
+			Policy:  delivery.LMP_SelfPickup,
+			Payment: delivery.PM_AlreadyPaid,
+			Actions: delivery.AvailableActions{UpdatePickupToPickupAvailable: true},
+			Point:   "prepaid",
+			Change:  true,
+		},
+		{
+			Name:    "payment not supported",
+			Policy:  delivery.LMP_SelfPickup,
+			Payment: delivery.PM_CashOnDelivery,
+			Actions: delivery.AvailableActions{UpdatePickupToPickupAvailable: true},
+			Point:   "prepaid",
+			Change:  true,
+			Err:     delivery.ErrPaymentMethodNotSupported,
+		},
+		{
+			Name:    "point not found",
+			Policy:  delivery.LMP_SelfPickup,
+			Actions: delivery.AvailableActions{UpdatePickupToPickupAvailable: true},
+			Point:   "unknown",
+			Change:  true,
+			Err:     delivery.ErrPointNotFound,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			var edited *delivery.EditRequestInfoRequest
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("/request/info", func(w http.ResponseWriter, r *http.Request) {
+				json.NewEncoder(w).Encode(delivery.GetRequestInfoResponse{RequestID: "req", Request: delivery.RequestInfo{
+					LastMilePolicy:   c.Policy,
+					BillingInfo:      delivery.BillingInfo{PaymentMethod: c.Payment},
+					RecipientInfo:    delivery.Contact{FirstName: "Иван"},
+					AvailableActions: &c.Actions,
+				}})
+			})
+			mux.HandleFunc("/pickup-points/list", func(w http.ResponseWriter, r *http.Request) {
+				req := delivery.DeliveryPointsRequest{}
+				json.NewDecoder(r.Body).Decode(&req)
+
+				resp := delivery.DeliveryPointsResponse{Points: []delivery.Point{}}
+				for _, point := range points {
+					if len(req.PickupPointIDS) == 1 && req.PickupPointIDS[0] == point.ID {
+						resp.Points = append(resp.Points, point)
+					}
+				}
+				json.NewEncoder(w).Encode(resp)
+			})
+			mux.HandleFunc("/request/edit", func(w http.ResponseWriter, r *http.Request) {
+				edited = &delivery.EditRequestInfoRequest{}
+				json.NewDecoder(r.Body).Decode(edited)
+				json.NewEncoder(w).Encode(delivery.EditRequestInfoResponse{EditID: "edit"})
+			})
+
+			// Фильтр точек клиента не влияет на проверку пункта выдачи
+			d := newTestDelivery(t, mux).WithPointsFilter(func(delivery.Point) bool { return false })
+
+			switchPoint := delivery.SwitchToPickup
+			if c.Change {
+				switchPoint = delivery.ChangePickupPoint
+			}
+
+			resp, err := switchPoint(d, "req", c.Point)
+			if c.Err != nil {
+				assert.ErrorIs(t, err, c.Err)
+				assert.Nil(t, edited)
+				return
+			}
+
+			if assert.NoError(t, err) && assert.NotNil(t, edited) {
+				assert.Equal(t, "edit", resp.EditID)
+				assert.Equal(t, string(delivery.LMP_SelfPickup), edited.LastMilePolicy)
+				assert.Equal(t, "platform_station", edited.Destination.Type)
+				assert.Equal(t, c.Point, edited.Destination.PlatformStation.PlatformID)
+				assert.Equal(t, "Иван", edited.RecipientInfo.FirstName)
+			}
+		})
+	}
+}
+
+func TestDelivery_SwitchToCourier(t *testing.T) {
+	now := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	var edited *delivery.EditRequestInfoRequest
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/request/info", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(delivery.GetRequestInfoResponse{RequestID: "req", Request: delivery.RequestInfo{
+			LastMilePolicy:   delivery.LMP_SelfPickup,
+			AvailableActions: &delivery.AvailableActions{UpdatePickupToCourierAvailable: true},
+		}})
+	})
+	mux.HandleFunc("/request/edit", func(w http.ResponseWriter, r *http.Request) {
+		edited = &delivery.EditRequestInfoRequest{}
+		json.NewDecoder(r.Body).Decode(edited)
+		json.NewEncoder(w).Encode(delivery.EditRequestInfoResponse{})
+	})
+	d := newTestDelivery(t, mux)
+
+	location := delivery.CustomLocation{
+		Latitude:  55.76,
+		Longitude: 37.61,
+		Details:   delivery.Address{FullAddress: "Москва, Тверская 1", Room: "12", Comment: "домофон 12"},
+	}
+
+	_, err := delivery.SwitchToCourier(d, "req", location, delivery.IntervalUTC{From: now, To: now.Add(time.Hour)})
+	if assert.NoError(t, err) && assert.NotNil(t, edited) {
+		assert.Equal(t, string(delivery.LMP_TimeInterval), edited.LastMilePolicy)
+		assert.Equal(t, "custom_location", edited.Destination.Type)
+		assert.Equal(t, location, *edited.Destination.CustomLocation)
+		assert.True(t, now.Equal(edited.Destination.IntervalUTC.From))
+	}
+}
@@ -1,15 +1,17 @@
 package delivery
 
 import (
-	"errors"
+	"context"
 	"fmt"
 	"io"
 	"net/http"
+	"slices"
 	"strconv"
 	"time"
 
 	"github.com/ReanSn0w/go-yandex-delivery/pkg/utils"
 	"github.com/ReanSn0w/gokit/pkg/web"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 const (
@@ -24,201 +26,282 @@ func New(api utils.API, env utils.Environment) *Delivery {
 		base = development
 	}
 
-	return &Delivery{api: api, base: base}
+	return &Delivery{api: api, base: base, ctx: context.Background()}
 }
 
 type Delivery struct {
-	api  utils.API
-	base string
+	api       utils.API
+	base      string
+	ctx       context.Context
+	oversized *OversizedLimits
+	filter    func(Point) bool
+	unix      bool
+	station   string
+}
+
+// WithContext возвращает копию клиента,
+// запросы которой выполняются в рамках переданного контекста
+func (d *Delivery) WithContext(ctx context.Context) DeliveryClient {
+	return d.withContext(ctx)
+}
+
+func (d *Delivery) withContext(ctx context.Context) *Delivery {
+	c := *d
+	c.ctx = ctx
+	return &c
 }
 
 func (d *Delivery) request(path string) *web.JsonRequest {
-	return d.api.Request(d.base, path)
+	return d.api.RequestContext(d.ctx, d.base, path)
+}
+
+// do выполняет запрос и передает разобранный ответ клиенту API,
+// если он реализует utils.ResponseObserver
+func (d *Delivery) do(req *web.JsonRequest, res any) error {
+	if err := req.Do(res); err != nil {
+		return err
+	}
+
+	if observer, ok := d.api.(utils.ResponseObserver); ok {
+		return observer.ObserveResponse(d.ctx, res)
+	}
+	return nil
 }
 
 // GetPredictedPrice возвращает предварительную оценку стоимости доставки
 // is_oversized - Флаг КГТ
 func (d *Delivery) GetPredictedPrice(isOversized bool, req PredictPriceRequest) (*PredictPriceResponse, error) {
+	isOversized = d.isOversized(isOversized, req.Places)
+	d, span := d.trace("GetPredictedPrice", attribute.Bool("is_oversized", isOversized))
+	req.Source = d.source(req.Source, false)
+
 	res := PredictPriceResponse{}
-	if err := d.request("/pricing-calculator").
+	if err := d.do(d.request("/pricing-calculator").
 		SetMethod(http.MethodPost).
 		SetQuery("is_oversized", fmt.Sprint(isOversized)).
-		SetBody(req).
-		Do(&res); err != nil {
-		return nil, err
+		SetBody(req), &res); err != nil {
+		return nil, endSpan(span, err)
 	}
-	return &res, nil
+	return &res, endSpan(span, nil)
 }
 
 // GetDeliveryIntervals возвращает интервалы доставки
 // is_oversized - Флаг КГТ
 func (d *Delivery) GetDeliveryIntervals(isOversized bool, lastMilePolicy LastMilePolicy, req DeliveryIntervalsRequest) (*DeliveryIntervalsResponse, error) {
+	isOversized = d.isOversized(isOversized, req.Places)
+	d, span := d.trace("GetDeliveryIntervals",
+		attribute.Bool("is_oversized", isOversized),
+		attribute.String("last_mile_policy", string(lastMilePolicy)))
+	req.Source = d.source(req.Source, false)
+
 	res := DeliveryIntervalsResponse{}
-	err := d.request("/offers/info").
+	err := d.do(d.request("/offers/info").
 		SetMethod(http.MethodPost).
 		SetQuery("is_oversized", fmt.Sprint(isOversized)).
 		SetQuery("last_mile_policy", string(lastMilePolicy)).
-		SetQuery("send_unix", "false").
-		SetBody(req).
-		Do(&res)
-	return &res, err
+		SetQuery("send_unix", strconv.FormatBool(d.unix)).
+		SetBody(req.wire(d.unix)), &res)
+	return &res, endSpan(span, err)
 }
 
 // GetLocationID возвращает идентификатор населенного пункта
 func (d *Delivery) GetLocationID(address string) (*LocationIDResponse, error) {
+	d, span := d.trace("GetLocationID")
+
 	res := LocationIDResponse{}
-	err := d.request("/location/detect").
+	err := d.do(d.request("/location/detect").
 		SetMethod(http.MethodPost).
-		SetBody(map[string]any{"location": address}).
-		Do(&res)
-	return &res, err
+		SetBody(map[string]any{"location": address}), &res)
+	return &res, endSpan(span, err)
 }
 
 // GetDeliveryPoints возвращает список точек самовывоза и ПВЗ
 func (d *Delivery) GetDeliveryPoints(req DeliveryPointsRequest) (*DeliveryPointsResponse, error) {
+	d, span := d.trace("GetDeliveryPoints", attribute.Int64("geo_id", req.GeoID))
+
 	res := DeliveryPointsResponse{}
-	err := d.request("/pickup-points/list").
+	err := d.do(d.request("/pickup-points/list").
 		SetMethod(http.MethodPost).
-		SetBody(req).
-		Do(&res)
-	return &res, err
+		SetBody(req), &res)
+	if err == nil && d.filter != nil {
+		res.Points = slices.DeleteFunc(res.Points, func(p Point) bool { return !d.filter(p) })
+	}
+	return &res, endSpan(span, err)
 }
 
 // CreateOffer создает заявку на доставку
 func (d *Delivery) CreateOffer(req CreateOfferRequest) (*CreateOfferResponse, error) {
+	d, span := d.trace("CreateOffer", attribute.String("operator_request_id", req.Info.OperatorRequestID))
+	req.Source = d.source(req.Source, true)
+
 	res := CreateOfferResponse{}
-	err := d.request("/offers/create").
+	err := d.do(d.request("/offers/create").
 		SetMethod(http.MethodPost).
-		SetQuery("send_unix", "false").
-		SetBody(req).
-		Do(&res)
-	return &res, err
+		SetQuery("send_unix", strconv.FormatBool(d.unix)).
+		SetBody(RequestInfo(req).wire(d.unix)), &res)
+	return &res, endSpan(span, err)
 }
 
 // ConfirmOffer подтверждает заявку на доставку
 func (d *Delivery) ConfirmOffer(offerID string) (*ConfirmOfferResponse, error) {
+	d, span := d.trace("ConfirmOffer", attribute.String("offer_id", offerID))
+
 	resp := ConfirmOfferResponse{}
-	err := d.request("/offers/confirm").
+	err := d.do(d.request("/offers/confirm").
 		SetMethod(http.MethodPost).
-		SetBody(map[string]any{"offer_id": offerID}).
-		Do(&resp)
-	return &resp, err
+		SetBody(map[string]any{"offer_id": offerID}), &resp)
+	span.SetAttributes(attribute.String("request_id", resp.RequestID))
+	return &resp, endSpan(span, err)
 }
 
 // GetRequestInfo возвращает информацию о заявке на доставку
 func (d *Delivery) GetRequestInfo(requestID string, slim bool) (*GetRequestInfoResponse, error) {
+	d, span := d.trace("GetRequestInfo", attribute.String("request_id", requestID))
+
 	resp := GetRequestInfoResponse{}
-	err := d.request("/request/info").
+	err := d.do(d.request("/request/info").
 		SetQuery("request_id", requestID).
-		SetQuery("slim", strconv.FormatBool(slim)).
-		Do(&resp)
-	return &resp, err
+		SetQuery("slim", strconv.FormatBool(slim)), &resp)
+	return &resp, endSpan(span, err)
 }
 
 // GetRequestsInfo получает информацию о заявках во временном интервале
 func (d *Delivery) GetRequestsInfo(from, to time.Time, requestsIds ...string) (*GetRequestsInfoResponse, error) {
+	d, span := d.trace("GetRequestsInfo", attribute.StringSlice("request_id", requestsIds))
+
 	res := GetRequestsInfoResponse{}
-	err := d.request("/requests/info").
+	err := d.do(d.request("/requests/info").
 		SetMethod(http.MethodPost).
 		SetBody(map[string]any{
 			"from":        from.Format(time.RFC3339),
 			"to":          to.Format(time.RFC3339),
 			"request_ids": requestsIds,
-		}).Do(&res)
-	return &res, err
+		}), &res)
+	return &res, endSpan(span, err)
 }
 
 // GetRequestActualInfo получeние актуальной информации о доставке
 func (d *Delivery) GetRequestActualInfo(requestID string) (*GetRequestActualInfoResponse, error) {
+	d, span := d.trace("GetRequestActualInfo", attribute.String("request_id", requestID))
+
 	res := GetRequestActualInfoResponse{}
-	err := d.request("/request/actual_info").
-		SetQuery("request_id", requestID).
-		Do(&res)
-	return &res, err
+	err := d.do(d.request("/request/actual_info").
+		SetQuery("request_id", requestID), &res)
+	return &res, endSpan(span, err)
 }
 
 // EditRequestInfo редактирует информацию о заказе
 func (d *Delivery) EditRequestInfo(req EditRequestInfoRequest) (*EditRequestInfoResponse, error) {
+	d, span := d.trace("EditRequestInfo", attribute.String("request_id", req.RequestID))
+
 	res := EditRequestInfoResponse{}
-	err := d.request("/request/edit").
-		SetBody(req).
-		Do(&res)
-	return &res, err
+	err := d.do(d.request("/request/edit").
+		SetBody(req), &res)
+	return &res, endSpan(span, err)
 }
 
 // GetRequestRedeliveryOptions получает интервалы доставки для нового места получения заказа
 func (d *Delivery) GetRequestRedeliveryOptions(req GetRequestRedeliveryOptionsRequest) (*GetRequestRedeliveryOptionsResponse, error) {
+	d, span := d.trace("GetRequestRedeliveryOptions", attribute.String("request_id", req.RequestID))
+
 	res := GetRequestRedeliveryOptionsResponse{}
-	err := d.request("/request/redelivery_options").
-		SetBody(req).
-		Do(&res)
-	return &res, err
+	err := d.do(d.request("/request/redelivery_options").
+		SetBody(req), &res)
+	return &res, endSpan(span, err)
 }
 
 // GetRequestHistory получает историю заявки
 func (d *Delivery) GetRequestHistory(requestID string) (*GetRequestHistoryResponse, error) {
+	d, span := d.trace("GetRequestHistory", attribute.String("request_id", requestID))
+
 	resp := GetRequestHistoryResponse{}
-	err := d.request("/request/history").
-		SetQuery("request_id", requestID).
-		Do(&resp)
-	return &resp, err
+	err := d.do(d.request("/request/history").
+		SetQuery("request_id", requestID), &resp)
+	return &resp, endSpan(span, err)
 }
 
 // CancelRequest отменяет заявку
 func (d *Delivery) CancelRequest(requestID string) (*CancelRequestResponse, error) {
+	return d.CancelRequestWithReason(requestID, "")
+}
+
+// CancelRequestWithReason отменяет заявку с указанием причины отмены
+func (d *Delivery) CancelRequestWithReason(requestID string, reason Reason) (*CancelRequestResponse, error) {
+	d, span := d.trace("CancelRequest",
+		attribute.String("request_id", requestID),
+		attribute.String("reason", string(reason)))
+
+	body := map[string]any{"request_id": requestID}
+	if reason != "" {
+		body["reason"] = reason
+	}
+
 	resp := CancelRequestResponse{}
-	err := d.request("/request/cancel").
+	err := d.do(d.request("/request/cancel").
 		SetMethod(http.MethodPost).
-		SetBody(map[string]any{"request_id": requestID}).
-		Do(&resp)
-	return &resp, err
+		SetBody(body), &resp)
+	return &resp, endSpan(span, err)
 }
 
 // CreateRequest создает новый заказ
 func (d *Delivery) CreateRequest(req CreateRequestRequest) (*CreateRequestResponse, error) {
+	d, span := d.trace("CreateRequest", attribute.String("operator_request_id", req.Info.OperatorRequestID))
+	req.Source = d.source(req.Source, true)
+
 	resp := CreateRequestResponse{}
-	err := d.request("/request/create").
+	err := d.do(d.request("/request/create").
 		SetMethod(http.MethodPost).
 		SetHeader("Accept-Language", "ru").
-		SetQuery("send_unix", "true").
-		SetBody(req).
-		Do(&resp)
-	return &resp, err
+		SetQuery("send_unix", strconv.FormatBool(d.unix)).
+		SetBody(RequestInfo(req).wire(d.unix)), &resp)
+	span.SetAttributes(attribute.String("request_id", resp.RequestID))
+	return &resp, endSpan(span, err)
 }
 
 // EditRequestPlaces редактирование грузомест заказа
 func (d *Delivery) EditRequestPlaces(req EditRequestPlacesRequest) (*EditRequestPlacesResponse, error) {
+	d, span := d.trace("EditRequestPlaces", attribute.String("request_id", req.RequestID))
+
 	res := EditRequestPlacesResponse{}
-	err := d.request("/request/places/edit").
-		SetBody(req).
-		Do(&res)
-	return &res, err
+	err := d.do(d.request("/request/places/edit").
+		SetBody(req), &res)
+	return &res, endSpan(span, err)
 }
 
 // GetEditRequestStatus получение статуса запроса на редактирование
 func (d *Delivery) GetEditRequestStatus(taskID string) (*GetEditRequestStatusResponse, error) {
+	d, span := d.trace("GetEditRequestStatus", attribute.String("editing_task_id", taskID))
+
 	resp := GetEditRequestStatusResponse{}
-	err := d.request("/request/edit/status").
-		SetQuery("editing_task_id", taskID).
-		Do(&resp)
-	return &resp, err
+	err := d.do(d.request("/request/edit/status").
+		SetQuery("editing_task_id", taskID), &resp)
+	return &resp, endSpan(span, err)
 }
 
 // EditRequestItems редактирование товаров заказа
 func (d *Delivery) EditRequestItems(req EditRequestItemsRequest) (*EditRequestItemsResponse, error) {
+	d, span := d.trace("EditRequestItems", attribute.String("request_id", req.RequestID))
+
 	res := EditRequestItemsResponse{}
-	err := d.request("/request/items-instances/edit").
-		SetBody(req).
-		Do(&res)
-	return &res, err
+	err := d.do(d.request("/request/items-instances/edit").
+		SetBody(req), &res)
+	return &res, endSpan(span, err)
 }
 
-// GenerateRequestLabels генерация транспортных ярлыков
+// GenerateRequestLabels генерация транспортных ярлыков.
+// Возвращает PDF файл, который необходимо закрыть после чтения
 func (d *Delivery) GenerateRequestLabels(req GenerateRequestLabelsRequest) (io.ReadCloser, error) {
-	return nil, errors.New("not implemented")
+	d, span := d.trace("GenerateRequestLabels", attribute.StringSlice("request_id", req.RequestIDS))
+
+	labels, err := d.api.Download(d.ctx, d.base, "/request/generate-labels", req)
+	return labels, endSpan(span, err)
 }
 
-// GetRequestHandoverAct получение акта приема/передачи отгрузки
+// GetRequestHandoverAct получение акта приема/передачи отгрузки.
+// Возвращает файл акта, который необходимо закрыть после чтения
 func (d *Delivery) GetRequestHandoverAct(requestIds ...string) (io.ReadCloser, error) {
-	return nil, errors.New("not implemented")
+	d, span := d.trace("GetRequestHandoverAct", attribute.StringSlice("request_id", requestIds))
+
+	act, err := d.api.Download(d.ctx, d.base, "/request/get-handover-act", map[string]any{"request_ids": requestIds})
+	return act, endSpan(span, err)
 }
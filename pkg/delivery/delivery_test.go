@@ -17,7 +17,7 @@ import (
 
 var (
 	l       lgr.L
-	d       *delivery.Delivery
+	d       delivery.DeliveryClient
 	barcode = tool.NewID()
 
 	opts = struct {
@@ -594,16 +594,17 @@ func TestDelivery_CreateOrderWOEditing(t *testing.T) {
 			}
 
 			// Шаг 6: Генерация ярлыков и получения акта приема/передачи.
-			// Эти методы возвращают ошибки "не реализовано", поэтому просто проверим их правильную обработку.
 			labels, labelsErr := d.GenerateRequestLabels(delivery.GenerateRequestLabelsRequest{
 				RequestIDS: []string{createReqResp.RequestID},
 			})
-			assert.NotNil(t, labelsErr)
-			assert.Nil(t, labels)
+			if assert.Nil(t, labelsErr) {
+				labels.Close()
+			}
 
 			handoverAct, handoverActErr := d.GetRequestHandoverAct(createReqResp.RequestID)
-			assert.NotNil(t, handoverActErr)
-			assert.Nil(t, handoverAct)
+			if assert.Nil(t, handoverActErr) {
+				handoverAct.Close()
+			}
 		})
 	}
 }
@@ -0,0 +1,220 @@
+// Package deliverytest содержит программируемую реализацию
+// delivery.DeliveryClient для тестов сервисов, использующих API доставки.
+// Сценарии пакета delivery (CreateOfferSet, Reschedule и другие) работают
+// с Fake через заданные ответы методов API
+package deliverytest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/delivery"
+)
+
+var (
+	ErrNotScripted = errors.New("deliverytest: response is not scripted")
+)
+
+var _ delivery.DeliveryClient = (*Fake)(nil)
+
+// Call вызов метода клиента
+type Call struct {
+	Method string
+	Args   []any
+}
+
+// New создает клиент без заданных ответов
+func New() *Fake {
+	return &Fake{responses: make(map[string][]response)}
+}
+
+// Fake клиент доставки, возвращающий заранее заданные ответы.
+//
+// Ответы каждого метода выдаются в порядке добавления через Return.
+// Если ответы метода закончились, вызов завершается ошибкой ErrNotScripted
+type Fake struct {
+	mutex     sync.Mutex
+	responses map[string][]response
+	calls     []Call
+}
+
+type response struct {
+	value any
+	err   error
+}
+
+// Return добавляет ответ метода method.
+// Тип value должен совпадать с первым возвращаемым значением метода
+func (f *Fake) Return(method string, value any, err error) *Fake {
+	expected := valueType(method)
+	if value != nil && !reflect.TypeOf(value).AssignableTo(expected) {
+		panic(fmt.Sprintf("deliverytest: %v returns %v, got %T", method, expected, value))
+	}
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.responses[method] = append(f.responses[method], response{value: value, err: err})
+	return f
+}
+
+// Calls возвращает вызовы метода method в порядке выполнения.
+// Если method пустой, возвращаются вызовы всех методов
+func (f *Fake) Calls(method string) []Call {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	calls := []Call{}
+	for _, call := range f.calls {
+		if method == "" || call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+func (f *Fake) call(method string, args ...any) (any, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.calls = append(f.calls, Call{Method: method, Args: args})
+
+	queue := f.responses[method]
+	if len(queue) == 0 {
+		return nil, fmt.Errorf("%w: %v", ErrNotScripted, method)
+	}
+
+	f.responses[method] = queue[1:]
+	return queue[0].value, queue[0].err
+}
+
+func valueType(method string) reflect.Type {
+	if strings.HasPrefix(method, "With") {
+		panic(fmt.Sprintf("deliverytest: %v returns the fake itself", method))
+	}
+
+	m, ok := reflect.TypeFor[delivery.DeliveryClient]().MethodByName(method)
+	if !ok {
+		panic(fmt.Sprintf("deliverytest: unknown method %v", method))
+	}
+	return m.Type.Out(0)
+}
+
+func result[T any](value any, err error) (T, error) {
+	v, _ := value.(T)
+	return v, err
+}
+
+// WithContext возвращает тот же клиент
+func (f *Fake) WithContext(ctx context.Context) delivery.DeliveryClient {
+	return f
+}
+
+// WithOversizedDetection возвращает тот же клиент.
+// Флаг КГТ передается в методы без изменений
+func (f *Fake) WithOversizedDetection(limits delivery.OversizedLimits) delivery.DeliveryClient {
+	return f
+}
+
+// WithPointsFilter возвращает тот же клиент.
+// Заданные ответы GetDeliveryPoints не фильтруются
+func (f *Fake) WithPointsFilter(filter func(delivery.Point) bool) delivery.DeliveryClient {
+	return f
+}
+
+// WithUnixTime возвращает тот же клиент
+func (f *Fake) WithUnixTime(enabled bool) delivery.DeliveryClient {
+	return f
+}
+
+// WithSourceStation возвращает тот же клиент.
+// Запросы передаются в Calls без подстановки склада
+func (f *Fake) WithSourceStation(stationID string) delivery.DeliveryClient {
+	return f
+}
+
+func (f *Fake) GetPredictedPrice(isOversized bool, req delivery.PredictPriceRequest) (*delivery.PredictPriceResponse, error) {
+	return result[*delivery.PredictPriceResponse](f.call("GetPredictedPrice", isOversized, req))
+}
+
+func (f *Fake) GetDeliveryIntervals(isOversized bool, lastMilePolicy delivery.LastMilePolicy, req delivery.DeliveryIntervalsRequest) (*delivery.DeliveryIntervalsResponse, error) {
+	return result[*delivery.DeliveryIntervalsResponse](f.call("GetDeliveryIntervals", isOversized, lastMilePolicy, req))
+}
+
+func (f *Fake) GetLocationID(address string) (*delivery.LocationIDResponse, error) {
+	return result[*delivery.LocationIDResponse](f.call("GetLocationID", address))
+}
+
+func (f *Fake) GetDeliveryPoints(req delivery.DeliveryPointsRequest) (*delivery.DeliveryPointsResponse, error) {
+	return result[*delivery.DeliveryPointsResponse](f.call("GetDeliveryPoints", req))
+}
+
+func (f *Fake) CreateOffer(req delivery.CreateOfferRequest) (*delivery.CreateOfferResponse, error) {
+	return result[*delivery.CreateOfferResponse](f.call("CreateOffer", req))
+}
+
+func (f *Fake) ConfirmOffer(offerID string) (*delivery.ConfirmOfferResponse, error) {
+	return result[*delivery.ConfirmOfferResponse](f.call("ConfirmOffer", offerID))
+}
+
+func (f *Fake) CreateRequest(req delivery.CreateRequestRequest) (*delivery.CreateRequestResponse, error) {
+	return result[*delivery.CreateRequestResponse](f.call("CreateRequest", req))
+}
+
+func (f *Fake) GetRequestInfo(requestID string, slim bool) (*delivery.GetRequestInfoResponse, error) {
+	return result[*delivery.GetRequestInfoResponse](f.call("GetRequestInfo", requestID, slim))
+}
+
+func (f *Fake) GetRequestsInfo(from, to time.Time, requestsIds ...string) (*delivery.GetRequestsInfoResponse, error) {
+	return result[*delivery.GetRequestsInfoResponse](f.call("GetRequestsInfo", from, to, requestsIds))
+}
+
+func (f *Fake) GetRequestActualInfo(requestID string) (*delivery.GetRequestActualInfoResponse, error) {
+	return result[*delivery.GetRequestActualInfoResponse](f.call("GetRequestActualInfo", requestID))
+}
+
+func (f *Fake) GetRequestHistory(requestID string) (*delivery.GetRequestHistoryResponse, error) {
+	return result[*delivery.GetRequestHistoryResponse](f.call("GetRequestHistory", requestID))
+}
+
+func (f *Fake) CancelRequest(requestID string) (*delivery.CancelRequestResponse, error) {
+	return result[*delivery.CancelRequestResponse](f.call("CancelRequest", requestID))
+}
+
+func (f *Fake) CancelRequestWithReason(requestID string, reason delivery.Reason) (*delivery.CancelRequestResponse, error) {
+	return result[*delivery.CancelRequestResponse](f.call("CancelRequestWithReason", requestID, reason))
+}
+
+func (f *Fake) EditRequestInfo(req delivery.EditRequestInfoRequest) (*delivery.EditRequestInfoResponse, error) {
+	return result[*delivery.EditRequestInfoResponse](f.call("EditRequestInfo", req))
+}
+
+func (f *Fake) GetRequestRedeliveryOptions(req delivery.GetRequestRedeliveryOptionsRequest) (*delivery.GetRequestRedeliveryOptionsResponse, error) {
+	return result[*delivery.GetRequestRedeliveryOptionsResponse](f.call("GetRequestRedeliveryOptions", req))
+}
+
+func (f *Fake) EditRequestPlaces(req delivery.EditRequestPlacesRequest) (*delivery.EditRequestPlacesResponse, error) {
+	return result[*delivery.EditRequestPlacesResponse](f.call("EditRequestPlaces", req))
+}
+
+func (f *Fake) EditRequestItems(req delivery.EditRequestItemsRequest) (*delivery.EditRequestItemsResponse, error) {
+	return result[*delivery.EditRequestItemsResponse](f.call("EditRequestItems", req))
+}
+
+func (f *Fake) GetEditRequestStatus(taskID string) (*delivery.GetEditRequestStatusResponse, error) {
+	return result[*delivery.GetEditRequestStatusResponse](f.call("GetEditRequestStatus", taskID))
+}
+
+func (f *Fake) GenerateRequestLabels(req delivery.GenerateRequestLabelsRequest) (io.ReadCloser, error) {
+	return result[io.ReadCloser](f.call("GenerateRequestLabels", req))
+}
+
+func (f *Fake) GetRequestHandoverAct(requestIds ...string) (io.ReadCloser, error) {
+	return result[io.ReadCloser](f.call("GetRequestHandoverAct", requestIds))
+}
@@ -0,0 +1,103 @@
+package deliverytest_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/delivery"
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/delivery/deliverytest"
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/delivery/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFake(t *testing.T) {
+	failure := errors.New("offer is not available")
+
+	var client delivery.DeliveryClient = deliverytest.New().
+		Return("CreateOffer", &delivery.CreateOfferResponse{Offers: []delivery.OfferItem{
+			{OfferID: "o1", ExpiresAt: time.Now().Add(time.Hour)},
+			{OfferID: "o2", ExpiresAt: time.Now().Add(time.Hour)},
+		}}, nil).
+		Return("ConfirmOffer", nil, failure)
+
+	set, err := delivery.CreateOfferSet(client, delivery.CreateOfferRequest{})
+	if assert.NoError(t, err) && assert.Len(t, set.Offers(), 2) {
+		_, err = set.Confirm(set.Offers()[1])
+		assert.ErrorIs(t, err, failure)
+	}
+
+	_, err = client.ConfirmOffer("o1")
+	assert.ErrorIs(t, err, deliverytest.ErrNotScripted)
+
+	calls := client.(*deliverytest.Fake).Calls("ConfirmOffer")
+	if assert.Len(t, calls, 2) {
+		assert.Equal(t, []any{"o2"}, calls[0].Args)
+	}
+}
+
+func TestFake_Tx(t *testing.T) {
+	failure := errors.New("commit failed")
+
+	fake := deliverytest.New().
+		Return("CreateRequest", &delivery.CreateRequestResponse{RequestID: "r1"}, nil).
+		Return("CancelRequestWithReason", &delivery.CancelRequestResponse{Status: "SUCCESS"}, nil)
+
+	requestID, err := delivery.CreateRequestTx(fake, delivery.CreateRequestRequest{}, "", func(requestID string) error {
+		assert.Equal(t, "r1", requestID)
+		return failure
+	})
+	assert.Equal(t, "r1", requestID)
+	assert.ErrorIs(t, err, failure)
+
+	calls := fake.Calls("CancelRequestWithReason")
+	if assert.Len(t, calls, 1) {
+		assert.Equal(t, []any{"r1", delivery.R_Cancel_ShopCanceled}, calls[0].Args)
+	}
+}
+
+func TestFake_Return(t *testing.T) {
+	assert.Panics(t, func() {
+		deliverytest.New().Return("CreateOffer", &delivery.ConfirmOfferResponse{}, nil)
+	})
+	assert.Panics(t, func() {
+		deliverytest.New().Return("Unknown", nil, nil)
+	})
+	assert.Panics(t, func() {
+		deliverytest.New().Return("WithPointsFilter", nil, nil)
+	})
+}
+
+func TestMock(t *testing.T) {
+	m := mocks.NewDeliveryClient(t)
+	m.EXPECT().GetRequestInfo("r1", true).
+		Return(&delivery.GetRequestInfoResponse{RequestID: "r1"}, nil).
+		Once()
+
+	var client delivery.DeliveryClient = m
+	resp, err := client.GetRequestInfo("r1", true)
+	assert.NoError(t, err)
+	assert.Equal(t, "r1", resp.RequestID)
+}
+
+func TestMock_Options(t *testing.T) {
+	m := mocks.NewDeliveryClient(t)
+
+	// Сценарий отключает определение КГТ через методы интерфейса
+	m.EXPECT().WithOversizedDetection(delivery.OversizedLimits{}).Return(m).Once()
+	m.EXPECT().WithContext(mock.Anything).Return(m).Once()
+	m.EXPECT().GetPredictedPrice(true, mock.Anything).
+		Return(&delivery.PredictPriceResponse{PricingTotal: "500 RUB"}, nil).
+		Once()
+
+	rows := delivery.ComparePrices(context.Background(), m, delivery.PredictPriceRequest{}, delivery.PriceMatrix{
+		Tariffs:   []delivery.LastMilePolicy{delivery.LMP_TimeInterval},
+		Oversized: []bool{true},
+	})
+	if assert.Len(t, rows, 1) {
+		assert.NoError(t, rows[0].Err)
+		assert.Equal(t, 500.0, rows[0].Total)
+	}
+}
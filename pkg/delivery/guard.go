@@ -0,0 +1,179 @@
+package delivery
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+const (
+	A_UpdateDates           Action = "update_dates_available"
+	A_UpdateAddress         Action = "update_address_available"
+	A_UpdateCourierToPickup Action = "update_courier_to_pickup_available"
+	A_UpdatePickupToCourier Action = "update_pickup_to_courier_available"
+	A_UpdatePickupToPickup  Action = "update_pickup_to_pickup_available"
+	A_UpdateItems           Action = "update_items"
+	A_UpdateRecipient       Action = "update_recipient"
+	A_UpdatePlaces          Action = "update_places"
+)
+
+var (
+	ErrActionNotAvailable = errors.New("action is not available for request")
+)
+
+// Action изменение заказа, разрешение на которое передается в AvailableActions.
+// Значение совпадает с названием флага в ответе API
+type Action string
+
+// Allowed проверяет, разрешено ли изменение заказа.
+// Если действия неизвестны, изменение считается запрещенным
+func (a *AvailableActions) Allowed(action Action) bool {
+	if a == nil {
+		return false
+	}
+
+	switch action {
+	case A_UpdateDates:
+		return a.UpdateDatesAvailable
+	case A_UpdateAddress:
+		return a.UpdateAddressAvailable
+	case A_UpdateCourierToPickup:
+		return a.UpdateCourierToPickupAvailable
+	case A_UpdatePickupToCourier:
+		return a.UpdatePickupToCourierAvailable
+	case A_UpdatePickupToPickup:
+		return a.UpdatePickupToPickupAvailable
+	case A_UpdateItems:
+		return a.UpdateItems
+	case A_UpdateRecipient:
+		return a.UpdateRecipient
+	case A_UpdatePlaces:
+		return a.UpdatePlaces
+	default:
+		return false
+	}
+}
+
+// ActionError описывает изменение, запрещенное для заказа.
+// Соответствует ErrActionNotAvailable
+type ActionError struct {
+	RequestID string // Идентификатор заказа
+	Action    Action // Флаг AvailableActions, запретивший изменение
+}
+
+func (e *ActionError) Error() string {
+	return fmt.Sprintf("request %v: %v is false", e.RequestID, e.Action)
+}
+
+func (e *ActionError) Is(target error) bool {
+	return target == ErrActionNotAvailable
+}
+
+// EditRequestInfoGuarded проверяет по AvailableActions, что изменения
+// разрешены для заказа, и только после этого вызывает EditRequestInfo
+func EditRequestInfoGuarded(client DeliveryClient, req EditRequestInfoRequest) (*EditRequestInfoResponse, error) {
+	info, err := client.GetRequestInfo(req.RequestID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkActions(req.RequestID, info.Request.AvailableActions, editActions(info.Request, req)...)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.EditRequestInfo(req)
+}
+
+// EditRequestPlacesGuarded вызывает EditRequestPlaces,
+// если для заказа разрешено изменение грузомест
+func EditRequestPlacesGuarded(client DeliveryClient, req EditRequestPlacesRequest) (*EditRequestPlacesResponse, error) {
+	err := requireActions(client, req.RequestID, A_UpdatePlaces)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.EditRequestPlaces(req)
+}
+
+// EditRequestItemsGuarded вызывает EditRequestItems,
+// если для заказа разрешено изменение товаров
+func EditRequestItemsGuarded(client DeliveryClient, req EditRequestItemsRequest) (*EditRequestItemsResponse, error) {
+	err := requireActions(client, req.RequestID, A_UpdateItems)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.EditRequestItems(req)
+}
+
+func requireActions(client DeliveryClient, requestID string, actions ...Action) error {
+	info, err := client.GetRequestInfo(requestID, false)
+	if err != nil {
+		return err
+	}
+
+	return checkActions(requestID, info.Request.AvailableActions, actions...)
+}
+
+func checkActions(requestID string, available *AvailableActions, actions ...Action) error {
+	for _, action := range actions {
+		if !available.Allowed(action) {
+			return &ActionError{RequestID: requestID, Action: action}
+		}
+	}
+
+	return nil
+}
+
+// editActions возвращает действия, необходимые для изменения заказа current на req
+func editActions(current RequestInfo, req EditRequestInfoRequest) []Action {
+	actions := []Action{}
+
+	if req.RecipientInfo != (Contact{}) && req.RecipientInfo != current.RecipientInfo {
+		actions = append(actions, A_UpdateRecipient)
+	}
+
+	policy := current.LastMilePolicy
+	if req.LastMilePolicy != "" {
+		policy = LastMilePolicy(req.LastMilePolicy)
+	}
+
+	switch {
+	case current.LastMilePolicy == LMP_TimeInterval && policy == LMP_SelfPickup:
+		actions = append(actions, A_UpdateCourierToPickup)
+	case current.LastMilePolicy == LMP_SelfPickup && policy == LMP_TimeInterval:
+		actions = append(actions, A_UpdatePickupToCourier)
+	case req.Destination != (Destination{}) && addressChanged(current.Destination, req.Destination):
+		if policy == LMP_SelfPickup {
+			actions = append(actions, A_UpdatePickupToPickup)
+		} else {
+			actions = append(actions, A_UpdateAddress)
+		}
+	}
+
+	if interval := req.Destination.IntervalUTC; interval != nil &&
+		(current.Destination.IntervalUTC == nil || !intervalEqual(*interval, *current.Destination.IntervalUTC)) {
+		actions = append(actions, A_UpdateDates)
+	}
+
+	if req.Places != nil && !reflect.DeepEqual(req.Places, placeElements(current.Places)) {
+		actions = append(actions, A_UpdatePlaces)
+	}
+
+	return actions
+}
+
+func intervalEqual(a, b IntervalUTC) bool {
+	return a.From.Equal(b.From) && a.To.Equal(b.To)
+}
+
+// placeElements преобразует грузоместа заказа в формат запроса на редактирование
+func placeElements(places []Place) []PlaceElement {
+	elements := make([]PlaceElement, 0, len(places))
+	for _, place := range places {
+		elements = append(elements, PlaceElement{Barcode: place.Barcode, Place: place})
+	}
+
+	return elements
+}
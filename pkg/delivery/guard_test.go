@@ -0,0 +1,136 @@
+package delivery_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/delivery"
+	"github.com/stretchr/testify/assert"
+)
+
+// requestInfoHandler отвечает на запрос информации о заказе и считает запросы на редактирование
+func requestInfoHandler(info delivery.RequestInfo, edits *int) *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/request/info", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(delivery.GetRequestInfoResponse{RequestID: "req", Request: info})
+	})
+	mux.HandleFunc("/request/edit", func(w http.ResponseWriter, r *http.Request) {
+		*edits++
+		json.NewEncoder(w).Encode(delivery.EditRequestInfoResponse{})
+	})
+	mux.HandleFunc("/request/places/edit", func(w http.ResponseWriter, r *http.Request) {
+		*edits++
+		json.NewEncoder(w).Encode(delivery.EditRequestPlacesResponse{})
+	})
+	mux.HandleFunc("/request/items-instances/edit", func(w http.ResponseWriter, r *http.Request) {
+		*edits++
+		json.NewEncoder(w).Encode(delivery.EditRequestItemsResponse{})
+	})
+	return mux
+}
+
+func TestDelivery_EditRequestInfoGuarded(t *testing.T) {
+	now := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	current := delivery.RequestInfo{
+		Destination:    delivery.Destination{Type: "custom_location", Address: "Москва, Тверская 1"},
+		RecipientInfo:  delivery.Contact{FirstName: "Иван", Phone: "+79990000000"},
+		LastMilePolicy: delivery.LMP_TimeInterval,
+	}
+
+	cases := []struct {
+		Name    string
+		Actions delivery.AvailableActions
+		Req     delivery.EditRequestInfoRequest
+		Blocked delivery.Action
+	}{
+		{
+			Name:    "recipient",
+			Actions: delivery.AvailableActions{UpdateRecipient: true},
+			Req:     delivery.EditRequestInfoRequest{RecipientInfo: delivery.Contact{FirstName: "Петр", Phone: "+79990000000"}},
+		},
+		{
+			Name:    "recipient blocked",
+			Actions: delivery.AvailableActions{UpdateAddressAvailable: true},
+			Req:     delivery.EditRequestInfoRequest{RecipientInfo: delivery.Contact{FirstName: "Петр", Phone: "+79990000000"}},
+			Blocked: delivery.A_UpdateRecipient,
+		},
+		{
+			Name:    "address blocked",
+			Actions: delivery.AvailableActions{UpdateRecipient: true},
+			Req:     delivery.EditRequestInfoRequest{Destination: delivery.Destination{Type: "custom_location", Address: "Москва, Арбат 2"}},
+			Blocked: delivery.A_UpdateAddress,
+		},
+		{
+			Name:    "courier to pickup blocked",
+			Actions: delivery.AvailableActions{UpdateAddressAvailable: true},
+			Req: delivery.EditRequestInfoRequest{
+				LastMilePolicy: string(delivery.LMP_SelfPickup),
+				Destination:    delivery.Destination{Type: "platform_station", PlatformStation: &delivery.PlatformStation{PlatformID: "point"}},
+			},
+			Blocked: delivery.A_UpdateCourierToPickup,
+		},
+		{
+			Name:    "dates blocked",
+			Actions: delivery.AvailableActions{UpdateAddressAvailable: true},
+			Req: delivery.EditRequestInfoRequest{
+				Destination: delivery.Destination{
+					Type:        "custom_location",
+					Address:     "Москва, Тверская 1",
+					IntervalUTC: &delivery.IntervalUTC{From: now, To: now.Add(time.Hour)},
+				},
+			},
+			Blocked: delivery.A_UpdateDates,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			edits := 0
+			info := current
+			info.AvailableActions = &c.Actions
+			d := newTestDelivery(t, requestInfoHandler(info, &edits))
+
+			c.Req.RequestID = "req"
+			_, err := delivery.EditRequestInfoGuarded(d, c.Req)
+			if c.Blocked == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, 1, edits)
+				return
+			}
+
+			actionErr := &delivery.ActionError{}
+			if assert.ErrorIs(t, err, delivery.ErrActionNotAvailable) && assert.True(t, errors.As(err, &actionErr)) {
+				assert.Equal(t, c.Blocked, actionErr.Action)
+				assert.Equal(t, "req", actionErr.RequestID)
+			}
+			assert.Zero(t, edits)
+		})
+	}
+}
+
+func TestDelivery_EditRequestPlacesGuarded(t *testing.T) {
+	edits := 0
+	d := newTestDelivery(t, requestInfoHandler(delivery.RequestInfo{
+		AvailableActions: &delivery.AvailableActions{UpdateItems: true},
+	}, &edits))
+
+	_, err := delivery.EditRequestPlacesGuarded(d, delivery.EditRequestPlacesRequest{RequestID: "req"})
+	assert.ErrorIs(t, err, delivery.ErrActionNotAvailable)
+	assert.EqualError(t, err, "request req: update_places is false")
+
+	_, err = delivery.EditRequestItemsGuarded(d, delivery.EditRequestItemsRequest{RequestID: "req"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, edits)
+}
+
+func TestAvailableActions_Allowed(t *testing.T) {
+	var actions *delivery.AvailableActions
+	assert.False(t, actions.Allowed(delivery.A_UpdateDates))
+
+	actions = &delivery.AvailableActions{UpdatePickupToPickupAvailable: true}
+	assert.True(t, actions.Allowed(delivery.A_UpdatePickupToPickup))
+	assert.False(t, actions.Allowed(delivery.A_UpdatePickupToCourier))
+}
@@ -0,0 +1,68 @@
+package delivery_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/delivery"
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/utils"
+	"github.com/ReanSn0w/gokit/pkg/web"
+)
+
+// testAPI направляет все запросы клиента на тестовый сервер
+type testAPI struct {
+	server *httptest.Server
+}
+
+func (a *testAPI) Request(base, path string) *web.JsonRequest {
+	return a.RequestContext(context.Background(), base, path)
+}
+
+func (a *testAPI) RequestContext(ctx context.Context, base, path string) *web.JsonRequest {
+	return web.NewJsonRequest(&testClient{ctx: ctx, client: a.server.Client()}, a.server.URL+path)
+}
+
+func (a *testAPI) Download(ctx context.Context, base, path string, body any) (io.ReadCloser, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.server.URL+path, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := a.server.Client().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		data, _ := io.ReadAll(resp.Body)
+		return nil, &utils.ResponseError{StatusCode: resp.StatusCode, Body: data}
+	}
+	return resp.Body, nil
+}
+
+type testClient struct {
+	ctx    context.Context
+	client *http.Client
+}
+
+func (c *testClient) Do(req *http.Request) (*http.Response, error) {
+	return c.client.Do(req.WithContext(c.ctx))
+}
+
+// newTestDelivery создает клиент доставки, обращающийся к тестовому обработчику
+func newTestDelivery(t *testing.T, handler http.Handler) *delivery.Delivery {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return delivery.New(&testAPI{server: server}, utils.Development)
+}
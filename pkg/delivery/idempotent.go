@@ -0,0 +1,203 @@
+package delivery
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+var (
+	ErrNoOperatorRequestID = errors.New("operator request id is required for idempotent creation")
+	ErrNoRequestLookup     = errors.New("index or search window is required for idempotent creation")
+)
+
+// Index хранит соответствие идентификатора заказа отправителя
+// (Info.OperatorRequestID) и идентификатора заказа в платформе
+type Index interface {
+	Lookup(operatorRequestID string) (requestID string, ok bool, err error)
+	Save(operatorRequestID, requestID string) error
+}
+
+// NewMemoryIndex создает индекс заказов в памяти процесса
+func NewMemoryIndex() *MemoryIndex {
+	return &MemoryIndex{values: make(map[string]string)}
+}
+
+type MemoryIndex struct {
+	mutex  sync.RWMutex
+	values map[string]string
+}
+
+func (m *MemoryIndex) Lookup(operatorRequestID string) (string, bool, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	requestID, ok := m.values[operatorRequestID]
+	return requestID, ok, nil
+}
+
+func (m *MemoryIndex) Save(operatorRequestID, requestID string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.values[operatorRequestID] = requestID
+	return nil
+}
+
+// IdempotencyOptions настройки идемпотентного создания заказа
+type IdempotencyOptions struct {
+	// Локальный индекс созданных заказов.
+	// Проверяется перед поиском заказа через GetRequestsInfo
+	Index Index
+
+	// Окно поиска существующих заказов через GetRequestsInfo.
+	// Значение по умолчанию: 24 часа. Отрицательное значение отключает поиск,
+	// в этом случае необходим Index
+	Window time.Duration
+
+	// Количество попыток создания заказа.
+	// Значение по умолчанию: 3
+	Attempts int
+
+	// Задержка между попытками.
+	// Значение по умолчанию: 1 секунда
+	Delay time.Duration
+}
+
+func (o IdempotencyOptions) withDefaults() IdempotencyOptions {
+	if o.Window == 0 {
+		o.Window = 24 * time.Hour
+	}
+	if o.Attempts <= 0 {
+		o.Attempts = 3
+	}
+	if o.Delay <= 0 {
+		o.Delay = time.Second
+	}
+	return o
+}
+
+// FindRequest ищет созданный заказ по идентификатору заказа отправителя
+// в локальном индексе и через GetRequestsInfo
+func FindRequest(client DeliveryClient, operatorRequestID string, opts IdempotencyOptions) (string, bool, error) {
+	opts = opts.withDefaults()
+
+	if opts.Index != nil {
+		requestID, ok, err := opts.Index.Lookup(operatorRequestID)
+		if err != nil || ok {
+			return requestID, ok, err
+		}
+	}
+
+	if opts.Window < 0 {
+		return "", false, nil
+	}
+
+	now := time.Now()
+	resp, err := client.GetRequestsInfo(now.Add(-opts.Window), now)
+	if err != nil {
+		return "", false, err
+	}
+
+	for _, req := range resp.Requests {
+		if req.Request.Info.OperatorRequestID == operatorRequestID {
+			if opts.Index != nil {
+				if err := opts.Index.Save(operatorRequestID, req.RequestID); err != nil {
+					return "", false, err
+				}
+			}
+
+			return req.RequestID, true, nil
+		}
+	}
+
+	return "", false, nil
+}
+
+// CreateRequestIdempotent создает заказ через CreateRequest, если заказ
+// с тем же Info.OperatorRequestID еще не существует.
+// При ошибке создания попытка повторяется после повторного поиска заказа.
+// created равен false, если возвращен идентификатор существующего заказа
+func CreateRequestIdempotent(ctx context.Context, client DeliveryClient, req CreateRequestRequest, opts IdempotencyOptions) (requestID string, created bool, err error) {
+	client = client.WithContext(ctx)
+	return idempotent(ctx, client, req.Info.OperatorRequestID, opts, func() (string, error) {
+		resp, err := client.CreateRequest(req)
+		if err != nil {
+			return "", err
+		}
+		return resp.RequestID, nil
+	})
+}
+
+// CreateOfferIdempotent создает и подтверждает первый оффер, если заказ
+// с тем же Info.OperatorRequestID еще не существует.
+// При ошибке создания попытка повторяется после повторного поиска заказа.
+// created равен false, если возвращен идентификатор существующего заказа
+func CreateOfferIdempotent(ctx context.Context, client DeliveryClient, req CreateOfferRequest, opts IdempotencyOptions) (requestID string, created bool, err error) {
+	client = client.WithContext(ctx)
+	return idempotent(ctx, client, req.Info.OperatorRequestID, opts, func() (string, error) {
+		offers, err := client.CreateOffer(req)
+		if err != nil {
+			return "", err
+		}
+
+		offer, err := firstOffer(offers.Offers)
+		if err != nil {
+			return "", err
+		}
+
+		resp, err := client.ConfirmOffer(offer.OfferID)
+		if err != nil {
+			return "", err
+		}
+		return resp.RequestID, nil
+	})
+}
+
+func idempotent(ctx context.Context, client DeliveryClient, operatorRequestID string, opts IdempotencyOptions, create func() (string, error)) (string, bool, error) {
+	if operatorRequestID == "" {
+		return "", false, ErrNoOperatorRequestID
+	}
+
+	opts = opts.withDefaults()
+	if opts.Window < 0 && opts.Index == nil {
+		return "", false, ErrNoRequestLookup
+	}
+
+	var errs []error
+	for attempt := range opts.Attempts {
+		if attempt > 0 {
+			select {
+			case <-time.After(opts.Delay):
+			case <-ctx.Done():
+				return "", false, errors.Join(append(errs, ctx.Err())...)
+			}
+		}
+
+		requestID, ok, err := FindRequest(client, operatorRequestID, opts)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if ok {
+			return requestID, false, nil
+		}
+
+		requestID, err = create()
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		if opts.Index != nil {
+			if err := opts.Index.Save(operatorRequestID, requestID); err != nil {
+				return requestID, true, err
+			}
+		}
+
+		return requestID, true, nil
+	}
+
+	return "", false, errors.Join(errs...)
+}
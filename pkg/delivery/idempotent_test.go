@@ -0,0 +1,131 @@
+package delivery_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/delivery"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDelivery_CreateRequestIdempotent(t *testing.T) {
+	var (
+		created  []delivery.RequestElement
+		creates  int
+		searches int
+	)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/request/create", func(w http.ResponseWriter, r *http.Request) {
+		creates++
+
+		req := delivery.CreateRequestRequest{}
+		json.NewDecoder(r.Body).Decode(&req)
+		created = append(created, delivery.RequestElement{
+			RequestID: "req-" + req.Info.OperatorRequestID,
+			Request:   delivery.RequestInfo(req),
+		})
+
+		// Заказ создан, но ответ не дошел до клиента
+		w.WriteHeader(http.StatusGatewayTimeout)
+	})
+	mux.HandleFunc("/requests/info", func(w http.ResponseWriter, r *http.Request) {
+		searches++
+		json.NewEncoder(w).Encode(delivery.GetRequestsInfoResponse{Requests: created})
+	})
+	d := newTestDelivery(t, mux)
+
+	index := delivery.NewMemoryIndex()
+	opts := delivery.IdempotencyOptions{Index: index, Delay: time.Millisecond}
+
+	req := delivery.CreateRequestRequest{Info: delivery.Info{OperatorRequestID: "order-1"}}
+
+	requestID, isNew, err := delivery.CreateRequestIdempotent(context.Background(), d, req, opts)
+	assert.NoError(t, err)
+	assert.False(t, isNew)
+	assert.Equal(t, "req-order-1", requestID)
+	assert.Equal(t, 1, creates)
+	assert.Equal(t, 2, searches)
+
+	// Повторный вызов использует локальный индекс
+	requestID, isNew, err = delivery.CreateRequestIdempotent(context.Background(), d, req, opts)
+	assert.NoError(t, err)
+	assert.False(t, isNew)
+	assert.Equal(t, "req-order-1", requestID)
+	assert.Equal(t, 1, creates)
+	assert.Equal(t, 2, searches)
+
+	_, _, err = delivery.CreateRequestIdempotent(context.Background(), d, delivery.CreateRequestRequest{}, opts)
+	assert.ErrorIs(t, err, delivery.ErrNoOperatorRequestID)
+
+	// Без индекса и поиска повторная попытка создала бы дубль
+	req.Info.OperatorRequestID = "order-2"
+	_, _, err = delivery.CreateRequestIdempotent(context.Background(), d, req, delivery.IdempotencyOptions{Window: -1})
+	assert.ErrorIs(t, err, delivery.ErrNoRequestLookup)
+	assert.Equal(t, 1, creates)
+}
+
+func TestDelivery_CreateOfferIdempotent(t *testing.T) {
+	var (
+		created  []delivery.RequestElement
+		offers   int
+		confirms int
+	)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/offers/create", func(w http.ResponseWriter, r *http.Request) {
+		offers++
+
+		req := delivery.CreateOfferRequest{}
+		json.NewDecoder(r.Body).Decode(&req)
+		json.NewEncoder(w).Encode(delivery.CreateOfferResponse{
+			Offers: []delivery.OfferItem{{OfferID: req.Info.OperatorRequestID + "-offer"}},
+		})
+	})
+	mux.HandleFunc("/offers/confirm", func(w http.ResponseWriter, r *http.Request) {
+		confirms++
+
+		// Первое подтверждение не доходит до API, второе выполняется успешно
+		if confirms == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		body := map[string]string{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, "order-1-offer", body["offer_id"])
+
+		created = append(created, delivery.RequestElement{
+			RequestID: "req-order-1",
+			Request:   delivery.RequestInfo{Info: delivery.Info{OperatorRequestID: "order-1"}},
+		})
+		json.NewEncoder(w).Encode(delivery.ConfirmOfferResponse{RequestID: "req-order-1"})
+	})
+	mux.HandleFunc("/requests/info", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(delivery.GetRequestsInfoResponse{Requests: created})
+	})
+	d := newTestDelivery(t, mux)
+
+	opts := delivery.IdempotencyOptions{Delay: time.Millisecond}
+	req := delivery.CreateOfferRequest{Info: delivery.Info{OperatorRequestID: "order-1"}}
+
+	requestID, isNew, err := delivery.CreateOfferIdempotent(context.Background(), d, req, opts)
+	assert.NoError(t, err)
+	assert.True(t, isNew)
+	assert.Equal(t, "req-order-1", requestID)
+	assert.Equal(t, 2, offers)
+	assert.Equal(t, 2, confirms)
+
+	// Заказ уже существует, новый оффер не создается
+	requestID, isNew, err = delivery.CreateOfferIdempotent(context.Background(), d, req, opts)
+	assert.NoError(t, err)
+	assert.False(t, isNew)
+	assert.Equal(t, "req-order-1", requestID)
+	assert.Equal(t, 2, offers)
+
+	_, _, err = delivery.CreateOfferIdempotent(context.Background(), d, delivery.CreateOfferRequest{}, opts)
+	assert.ErrorIs(t, err, delivery.ErrNoOperatorRequestID)
+}
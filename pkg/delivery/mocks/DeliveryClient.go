@@ -0,0 +1,1475 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	io "io"
+
+	delivery "github.com/ReanSn0w/go-yandex-delivery/pkg/delivery"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// DeliveryClient is an autogenerated mock type for the DeliveryClient type
+type DeliveryClient struct {
+	mock.Mock
+}
+
+type DeliveryClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DeliveryClient) EXPECT() *DeliveryClient_Expecter {
+	return &DeliveryClient_Expecter{mock: &_m.Mock}
+}
+
+// CancelRequest provides a mock function with given fields: requestID
+func (_m *DeliveryClient) CancelRequest(requestID string) (*delivery.CancelRequestResponse, error) {
+	ret := _m.Called(requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelRequest")
+	}
+
+	var r0 *delivery.CancelRequestResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*delivery.CancelRequestResponse, error)); ok {
+		return rf(requestID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *delivery.CancelRequestResponse); ok {
+		r0 = rf(requestID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*delivery.CancelRequestResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeliveryClient_CancelRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelRequest'
+type DeliveryClient_CancelRequest_Call struct {
+	*mock.Call
+}
+
+// CancelRequest is a helper method to define mock.On call
+//   - requestID string
+func (_e *DeliveryClient_Expecter) CancelRequest(requestID interface{}) *DeliveryClient_CancelRequest_Call {
+	return &DeliveryClient_CancelRequest_Call{Call: _e.mock.On("CancelRequest", requestID)}
+}
+
+func (_c *DeliveryClient_CancelRequest_Call) Run(run func(requestID string)) *DeliveryClient_CancelRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *DeliveryClient_CancelRequest_Call) Return(_a0 *delivery.CancelRequestResponse, _a1 error) *DeliveryClient_CancelRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeliveryClient_CancelRequest_Call) RunAndReturn(run func(string) (*delivery.CancelRequestResponse, error)) *DeliveryClient_CancelRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CancelRequestWithReason provides a mock function with given fields: requestID, reason
+func (_m *DeliveryClient) CancelRequestWithReason(requestID string, reason delivery.Reason) (*delivery.CancelRequestResponse, error) {
+	ret := _m.Called(requestID, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelRequestWithReason")
+	}
+
+	var r0 *delivery.CancelRequestResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, delivery.Reason) (*delivery.CancelRequestResponse, error)); ok {
+		return rf(requestID, reason)
+	}
+	if rf, ok := ret.Get(0).(func(string, delivery.Reason) *delivery.CancelRequestResponse); ok {
+		r0 = rf(requestID, reason)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*delivery.CancelRequestResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, delivery.Reason) error); ok {
+		r1 = rf(requestID, reason)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeliveryClient_CancelRequestWithReason_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelRequestWithReason'
+type DeliveryClient_CancelRequestWithReason_Call struct {
+	*mock.Call
+}
+
+// CancelRequestWithReason is a helper method to define mock.On call
+//   - requestID string
+//   - reason delivery.Reason
+func (_e *DeliveryClient_Expecter) CancelRequestWithReason(requestID interface{}, reason interface{}) *DeliveryClient_CancelRequestWithReason_Call {
+	return &DeliveryClient_CancelRequestWithReason_Call{Call: _e.mock.On("CancelRequestWithReason", requestID, reason)}
+}
+
+func (_c *DeliveryClient_CancelRequestWithReason_Call) Run(run func(requestID string, reason delivery.Reason)) *DeliveryClient_CancelRequestWithReason_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(delivery.Reason))
+	})
+	return _c
+}
+
+func (_c *DeliveryClient_CancelRequestWithReason_Call) Return(_a0 *delivery.CancelRequestResponse, _a1 error) *DeliveryClient_CancelRequestWithReason_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeliveryClient_CancelRequestWithReason_Call) RunAndReturn(run func(string, delivery.Reason) (*delivery.CancelRequestResponse, error)) *DeliveryClient_CancelRequestWithReason_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConfirmOffer provides a mock function with given fields: offerID
+func (_m *DeliveryClient) ConfirmOffer(offerID string) (*delivery.ConfirmOfferResponse, error) {
+	ret := _m.Called(offerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfirmOffer")
+	}
+
+	var r0 *delivery.ConfirmOfferResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*delivery.ConfirmOfferResponse, error)); ok {
+		return rf(offerID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *delivery.ConfirmOfferResponse); ok {
+		r0 = rf(offerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*delivery.ConfirmOfferResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(offerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeliveryClient_ConfirmOffer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfirmOffer'
+type DeliveryClient_ConfirmOffer_Call struct {
+	*mock.Call
+}
+
+// ConfirmOffer is a helper method to define mock.On call
+//   - offerID string
+func (_e *DeliveryClient_Expecter) ConfirmOffer(offerID interface{}) *DeliveryClient_ConfirmOffer_Call {
+	return &DeliveryClient_ConfirmOffer_Call{Call: _e.mock.On("ConfirmOffer", offerID)}
+}
+
+func (_c *DeliveryClient_ConfirmOffer_Call) Run(run func(offerID string)) *DeliveryClient_ConfirmOffer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *DeliveryClient_ConfirmOffer_Call) Return(_a0 *delivery.ConfirmOfferResponse, _a1 error) *DeliveryClient_ConfirmOffer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeliveryClient_ConfirmOffer_Call) RunAndReturn(run func(string) (*delivery.ConfirmOfferResponse, error)) *DeliveryClient_ConfirmOffer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOffer provides a mock function with given fields: req
+func (_m *DeliveryClient) CreateOffer(req delivery.CreateOfferRequest) (*delivery.CreateOfferResponse, error) {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOffer")
+	}
+
+	var r0 *delivery.CreateOfferResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(delivery.CreateOfferRequest) (*delivery.CreateOfferResponse, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(delivery.CreateOfferRequest) *delivery.CreateOfferResponse); ok {
+		r0 = rf(req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*delivery.CreateOfferResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(delivery.CreateOfferRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeliveryClient_CreateOffer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOffer'
+type DeliveryClient_CreateOffer_Call struct {
+	*mock.Call
+}
+
+// CreateOffer is a helper method to define mock.On call
+//   - req delivery.CreateOfferRequest
+func (_e *DeliveryClient_Expecter) CreateOffer(req interface{}) *DeliveryClient_CreateOffer_Call {
+	return &DeliveryClient_CreateOffer_Call{Call: _e.mock.On("CreateOffer", req)}
+}
+
+func (_c *DeliveryClient_CreateOffer_Call) Run(run func(req delivery.CreateOfferRequest)) *DeliveryClient_CreateOffer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(delivery.CreateOfferRequest))
+	})
+	return _c
+}
+
+func (_c *DeliveryClient_CreateOffer_Call) Return(_a0 *delivery.CreateOfferResponse, _a1 error) *DeliveryClient_CreateOffer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeliveryClient_CreateOffer_Call) RunAndReturn(run func(delivery.CreateOfferRequest) (*delivery.CreateOfferResponse, error)) *DeliveryClient_CreateOffer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateRequest provides a mock function with given fields: req
+func (_m *DeliveryClient) CreateRequest(req delivery.CreateRequestRequest) (*delivery.CreateRequestResponse, error) {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRequest")
+	}
+
+	var r0 *delivery.CreateRequestResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(delivery.CreateRequestRequest) (*delivery.CreateRequestResponse, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(delivery.CreateRequestRequest) *delivery.CreateRequestResponse); ok {
+		r0 = rf(req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*delivery.CreateRequestResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(delivery.CreateRequestRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeliveryClient_CreateRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRequest'
+type DeliveryClient_CreateRequest_Call struct {
+	*mock.Call
+}
+
+// CreateRequest is a helper method to define mock.On call
+//   - req delivery.CreateRequestRequest
+func (_e *DeliveryClient_Expecter) CreateRequest(req interface{}) *DeliveryClient_CreateRequest_Call {
+	return &DeliveryClient_CreateRequest_Call{Call: _e.mock.On("CreateRequest", req)}
+}
+
+func (_c *DeliveryClient_CreateRequest_Call) Run(run func(req delivery.CreateRequestRequest)) *DeliveryClient_CreateRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(delivery.CreateRequestRequest))
+	})
+	return _c
+}
+
+func (_c *DeliveryClient_CreateRequest_Call) Return(_a0 *delivery.CreateRequestResponse, _a1 error) *DeliveryClient_CreateRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeliveryClient_CreateRequest_Call) RunAndReturn(run func(delivery.CreateRequestRequest) (*delivery.CreateRequestResponse, error)) *DeliveryClient_CreateRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EditRequestInfo provides a mock function with given fields: req
+func (_m *DeliveryClient) EditRequestInfo(req delivery.EditRequestInfoRequest) (*delivery.EditRequestInfoResponse, error) {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EditRequestInfo")
+	}
+
+	var r0 *delivery.EditRequestInfoResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(delivery.EditRequestInfoRequest) (*delivery.EditRequestInfoResponse, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(delivery.EditRequestInfoRequest) *delivery.EditRequestInfoResponse); ok {
+		r0 = rf(req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*delivery.EditRequestInfoResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(delivery.EditRequestInfoRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeliveryClient_EditRequestInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EditRequestInfo'
+type DeliveryClient_EditRequestInfo_Call struct {
+	*mock.Call
+}
+
+// EditRequestInfo is a helper method to define mock.On call
+//   - req delivery.EditRequestInfoRequest
+func (_e *DeliveryClient_Expecter) EditRequestInfo(req interface{}) *DeliveryClient_EditRequestInfo_Call {
+	return &DeliveryClient_EditRequestInfo_Call{Call: _e.mock.On("EditRequestInfo", req)}
+}
+
+func (_c *DeliveryClient_EditRequestInfo_Call) Run(run func(req delivery.EditRequestInfoRequest)) *DeliveryClient_EditRequestInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(delivery.EditRequestInfoRequest))
+	})
+	return _c
+}
+
+func (_c *DeliveryClient_EditRequestInfo_Call) Return(_a0 *delivery.EditRequestInfoResponse, _a1 error) *DeliveryClient_EditRequestInfo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeliveryClient_EditRequestInfo_Call) RunAndReturn(run func(delivery.EditRequestInfoRequest) (*delivery.EditRequestInfoResponse, error)) *DeliveryClient_EditRequestInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EditRequestItems provides a mock function with given fields: req
+func (_m *DeliveryClient) EditRequestItems(req delivery.EditRequestItemsRequest) (*delivery.EditRequestItemsResponse, error) {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EditRequestItems")
+	}
+
+	var r0 *delivery.EditRequestItemsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(delivery.EditRequestItemsRequest) (*delivery.EditRequestItemsResponse, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(delivery.EditRequestItemsRequest) *delivery.EditRequestItemsResponse); ok {
+		r0 = rf(req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*delivery.EditRequestItemsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(delivery.EditRequestItemsRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeliveryClient_EditRequestItems_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EditRequestItems'
+type DeliveryClient_EditRequestItems_Call struct {
+	*mock.Call
+}
+
+// EditRequestItems is a helper method to define mock.On call
+//   - req delivery.EditRequestItemsRequest
+func (_e *DeliveryClient_Expecter) EditRequestItems(req interface{}) *DeliveryClient_EditRequestItems_Call {
+	return &DeliveryClient_EditRequestItems_Call{Call: _e.mock.On("EditRequestItems", req)}
+}
+
+func (_c *DeliveryClient_EditRequestItems_Call) Run(run func(req delivery.EditRequestItemsRequest)) *DeliveryClient_EditRequestItems_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(delivery.EditRequestItemsRequest))
+	})
+	return _c
+}
+
+func (_c *DeliveryClient_EditRequestItems_Call) Return(_a0 *delivery.EditRequestItemsResponse, _a1 error) *DeliveryClient_EditRequestItems_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeliveryClient_EditRequestItems_Call) RunAndReturn(run func(delivery.EditRequestItemsRequest) (*delivery.EditRequestItemsResponse, error)) *DeliveryClient_EditRequestItems_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EditRequestPlaces provides a mock function with given fields: req
+func (_m *DeliveryClient) EditRequestPlaces(req delivery.EditRequestPlacesRequest) (*delivery.EditRequestPlacesResponse, error) {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EditRequestPlaces")
+	}
+
+	var r0 *delivery.EditRequestPlacesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(delivery.EditRequestPlacesRequest) (*delivery.EditRequestPlacesResponse, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(delivery.EditRequestPlacesRequest) *delivery.EditRequestPlacesResponse); ok {
+		r0 = rf(req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*delivery.EditRequestPlacesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(delivery.EditRequestPlacesRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeliveryClient_EditRequestPlaces_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EditRequestPlaces'
+type DeliveryClient_EditRequestPlaces_Call struct {
+	*mock.Call
+}
+
+// EditRequestPlaces is a helper method to define mock.On call
+//   - req delivery.EditRequestPlacesRequest
+func (_e *DeliveryClient_Expecter) EditRequestPlaces(req interface{}) *DeliveryClient_EditRequestPlaces_Call {
+	return &DeliveryClient_EditRequestPlaces_Call{Call: _e.mock.On("EditRequestPlaces", req)}
+}
+
+func (_c *DeliveryClient_EditRequestPlaces_Call) Run(run func(req delivery.EditRequestPlacesRequest)) *DeliveryClient_EditRequestPlaces_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(delivery.EditRequestPlacesRequest))
+	})
+	return _c
+}
+
+func (_c *DeliveryClient_EditRequestPlaces_Call) Return(_a0 *delivery.EditRequestPlacesResponse, _a1 error) *DeliveryClient_EditRequestPlaces_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeliveryClient_EditRequestPlaces_Call) RunAndReturn(run func(delivery.EditRequestPlacesRequest) (*delivery.EditRequestPlacesResponse, error)) *DeliveryClient_EditRequestPlaces_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GenerateRequestLabels provides a mock function with given fields: req
+func (_m *DeliveryClient) GenerateRequestLabels(req delivery.GenerateRequestLabelsRequest) (io.ReadCloser, error) {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateRequestLabels")
+	}
+
+	var r0 io.ReadCloser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(delivery.GenerateRequestLabelsRequest) (io.ReadCloser, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(delivery.GenerateRequestLabelsRequest) io.ReadCloser); ok {
+		r0 = rf(req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(delivery.GenerateRequestLabelsRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeliveryClient_GenerateRequestLabels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateRequestLabels'
+type DeliveryClient_GenerateRequestLabels_Call struct {
+	*mock.Call
+}
+
+// GenerateRequestLabels is a helper method to define mock.On call
+//   - req delivery.GenerateRequestLabelsRequest
+func (_e *DeliveryClient_Expecter) GenerateRequestLabels(req interface{}) *DeliveryClient_GenerateRequestLabels_Call {
+	return &DeliveryClient_GenerateRequestLabels_Call{Call: _e.mock.On("GenerateRequestLabels", req)}
+}
+
+func (_c *DeliveryClient_GenerateRequestLabels_Call) Run(run func(req delivery.GenerateRequestLabelsRequest)) *DeliveryClient_GenerateRequestLabels_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(delivery.GenerateRequestLabelsRequest))
+	})
+	return _c
+}
+
+func (_c *DeliveryClient_GenerateRequestLabels_Call) Return(_a0 io.ReadCloser, _a1 error) *DeliveryClient_GenerateRequestLabels_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeliveryClient_GenerateRequestLabels_Call) RunAndReturn(run func(delivery.GenerateRequestLabelsRequest) (io.ReadCloser, error)) *DeliveryClient_GenerateRequestLabels_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDeliveryIntervals provides a mock function with given fields: isOversized, lastMilePolicy, req
+func (_m *DeliveryClient) GetDeliveryIntervals(isOversized bool, lastMilePolicy delivery.LastMilePolicy, req delivery.DeliveryIntervalsRequest) (*delivery.DeliveryIntervalsResponse, error) {
+	ret := _m.Called(isOversized, lastMilePolicy, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeliveryIntervals")
+	}
+
+	var r0 *delivery.DeliveryIntervalsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(bool, delivery.LastMilePolicy, delivery.DeliveryIntervalsRequest) (*delivery.DeliveryIntervalsResponse, error)); ok {
+		return rf(isOversized, lastMilePolicy, req)
+	}
+	if rf, ok := ret.Get(0).(func(bool, delivery.LastMilePolicy, delivery.DeliveryIntervalsRequest) *delivery.DeliveryIntervalsResponse); ok {
+		r0 = rf(isOversized, lastMilePolicy, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*delivery.DeliveryIntervalsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(bool, delivery.LastMilePolicy, delivery.DeliveryIntervalsRequest) error); ok {
+		r1 = rf(isOversized, lastMilePolicy, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeliveryClient_GetDeliveryIntervals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeliveryIntervals'
+type DeliveryClient_GetDeliveryIntervals_Call struct {
+	*mock.Call
+}
+
+// GetDeliveryIntervals is a helper method to define mock.On call
+//   - isOversized bool
+//   - lastMilePolicy delivery.LastMilePolicy
+//   - req delivery.DeliveryIntervalsRequest
+func (_e *DeliveryClient_Expecter) GetDeliveryIntervals(isOversized interface{}, lastMilePolicy interface{}, req interface{}) *DeliveryClient_GetDeliveryIntervals_Call {
+	return &DeliveryClient_GetDeliveryIntervals_Call{Call: _e.mock.On("GetDeliveryIntervals", isOversized, lastMilePolicy, req)}
+}
+
+func (_c *DeliveryClient_GetDeliveryIntervals_Call) Run(run func(isOversized bool, lastMilePolicy delivery.LastMilePolicy, req delivery.DeliveryIntervalsRequest)) *DeliveryClient_GetDeliveryIntervals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(bool), args[1].(delivery.LastMilePolicy), args[2].(delivery.DeliveryIntervalsRequest))
+	})
+	return _c
+}
+
+func (_c *DeliveryClient_GetDeliveryIntervals_Call) Return(_a0 *delivery.DeliveryIntervalsResponse, _a1 error) *DeliveryClient_GetDeliveryIntervals_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeliveryClient_GetDeliveryIntervals_Call) RunAndReturn(run func(bool, delivery.LastMilePolicy, delivery.DeliveryIntervalsRequest) (*delivery.DeliveryIntervalsResponse, error)) *DeliveryClient_GetDeliveryIntervals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDeliveryPoints provides a mock function with given fields: req
+func (_m *DeliveryClient) GetDeliveryPoints(req delivery.DeliveryPointsRequest) (*delivery.DeliveryPointsResponse, error) {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeliveryPoints")
+	}
+
+	var r0 *delivery.DeliveryPointsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(delivery.DeliveryPointsRequest) (*delivery.DeliveryPointsResponse, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(delivery.DeliveryPointsRequest) *delivery.DeliveryPointsResponse); ok {
+		r0 = rf(req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*delivery.DeliveryPointsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(delivery.DeliveryPointsRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeliveryClient_GetDeliveryPoints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeliveryPoints'
+type DeliveryClient_GetDeliveryPoints_Call struct {
+	*mock.Call
+}
+
+// GetDeliveryPoints is a helper method to define mock.On call
+//   - req delivery.DeliveryPointsRequest
+func (_e *DeliveryClient_Expecter) GetDeliveryPoints(req interface{}) *DeliveryClient_GetDeliveryPoints_Call {
+	return &DeliveryClient_GetDeliveryPoints_Call{Call: _e.mock.On("GetDeliveryPoints", req)}
+}
+
+func (_c *DeliveryClient_GetDeliveryPoints_Call) Run(run func(req delivery.DeliveryPointsRequest)) *DeliveryClient_GetDeliveryPoints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(delivery.DeliveryPointsRequest))
+	})
+	return _c
+}
+
+func (_c *DeliveryClient_GetDeliveryPoints_Call) Return(_a0 *delivery.DeliveryPointsResponse, _a1 error) *DeliveryClient_GetDeliveryPoints_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeliveryClient_GetDeliveryPoints_Call) RunAndReturn(run func(delivery.DeliveryPointsRequest) (*delivery.DeliveryPointsResponse, error)) *DeliveryClient_GetDeliveryPoints_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEditRequestStatus provides a mock function with given fields: taskID
+func (_m *DeliveryClient) GetEditRequestStatus(taskID string) (*delivery.GetEditRequestStatusResponse, error) {
+	ret := _m.Called(taskID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEditRequestStatus")
+	}
+
+	var r0 *delivery.GetEditRequestStatusResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*delivery.GetEditRequestStatusResponse, error)); ok {
+		return rf(taskID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *delivery.GetEditRequestStatusResponse); ok {
+		r0 = rf(taskID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*delivery.GetEditRequestStatusResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(taskID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeliveryClient_GetEditRequestStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEditRequestStatus'
+type DeliveryClient_GetEditRequestStatus_Call struct {
+	*mock.Call
+}
+
+// GetEditRequestStatus is a helper method to define mock.On call
+//   - taskID string
+func (_e *DeliveryClient_Expecter) GetEditRequestStatus(taskID interface{}) *DeliveryClient_GetEditRequestStatus_Call {
+	return &DeliveryClient_GetEditRequestStatus_Call{Call: _e.mock.On("GetEditRequestStatus", taskID)}
+}
+
+func (_c *DeliveryClient_GetEditRequestStatus_Call) Run(run func(taskID string)) *DeliveryClient_GetEditRequestStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *DeliveryClient_GetEditRequestStatus_Call) Return(_a0 *delivery.GetEditRequestStatusResponse, _a1 error) *DeliveryClient_GetEditRequestStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeliveryClient_GetEditRequestStatus_Call) RunAndReturn(run func(string) (*delivery.GetEditRequestStatusResponse, error)) *DeliveryClient_GetEditRequestStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLocationID provides a mock function with given fields: address
+func (_m *DeliveryClient) GetLocationID(address string) (*delivery.LocationIDResponse, error) {
+	ret := _m.Called(address)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLocationID")
+	}
+
+	var r0 *delivery.LocationIDResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*delivery.LocationIDResponse, error)); ok {
+		return rf(address)
+	}
+	if rf, ok := ret.Get(0).(func(string) *delivery.LocationIDResponse); ok {
+		r0 = rf(address)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*delivery.LocationIDResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(address)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeliveryClient_GetLocationID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLocationID'
+type DeliveryClient_GetLocationID_Call struct {
+	*mock.Call
+}
+
+// GetLocationID is a helper method to define mock.On call
+//   - address string
+func (_e *DeliveryClient_Expecter) GetLocationID(address interface{}) *DeliveryClient_GetLocationID_Call {
+	return &DeliveryClient_GetLocationID_Call{Call: _e.mock.On("GetLocationID", address)}
+}
+
+func (_c *DeliveryClient_GetLocationID_Call) Run(run func(address string)) *DeliveryClient_GetLocationID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *DeliveryClient_GetLocationID_Call) Return(_a0 *delivery.LocationIDResponse, _a1 error) *DeliveryClient_GetLocationID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeliveryClient_GetLocationID_Call) RunAndReturn(run func(string) (*delivery.LocationIDResponse, error)) *DeliveryClient_GetLocationID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPredictedPrice provides a mock function with given fields: isOversized, req
+func (_m *DeliveryClient) GetPredictedPrice(isOversized bool, req delivery.PredictPriceRequest) (*delivery.PredictPriceResponse, error) {
+	ret := _m.Called(isOversized, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPredictedPrice")
+	}
+
+	var r0 *delivery.PredictPriceResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(bool, delivery.PredictPriceRequest) (*delivery.PredictPriceResponse, error)); ok {
+		return rf(isOversized, req)
+	}
+	if rf, ok := ret.Get(0).(func(bool, delivery.PredictPriceRequest) *delivery.PredictPriceResponse); ok {
+		r0 = rf(isOversized, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*delivery.PredictPriceResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(bool, delivery.PredictPriceRequest) error); ok {
+		r1 = rf(isOversized, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeliveryClient_GetPredictedPrice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPredictedPrice'
+type DeliveryClient_GetPredictedPrice_Call struct {
+	*mock.Call
+}
+
+// GetPredictedPrice is a helper method to define mock.On call
+//   - isOversized bool
+//   - req delivery.PredictPriceRequest
+func (_e *DeliveryClient_Expecter) GetPredictedPrice(isOversized interface{}, req interface{}) *DeliveryClient_GetPredictedPrice_Call {
+	return &DeliveryClient_GetPredictedPrice_Call{Call: _e.mock.On("GetPredictedPrice", isOversized, req)}
+}
+
+func (_c *DeliveryClient_GetPredictedPrice_Call) Run(run func(isOversized bool, req delivery.PredictPriceRequest)) *DeliveryClient_GetPredictedPrice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(bool), args[1].(delivery.PredictPriceRequest))
+	})
+	return _c
+}
+
+func (_c *DeliveryClient_GetPredictedPrice_Call) Return(_a0 *delivery.PredictPriceResponse, _a1 error) *DeliveryClient_GetPredictedPrice_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeliveryClient_GetPredictedPrice_Call) RunAndReturn(run func(bool, delivery.PredictPriceRequest) (*delivery.PredictPriceResponse, error)) *DeliveryClient_GetPredictedPrice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRequestActualInfo provides a mock function with given fields: requestID
+func (_m *DeliveryClient) GetRequestActualInfo(requestID string) (*delivery.GetRequestActualInfoResponse, error) {
+	ret := _m.Called(requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRequestActualInfo")
+	}
+
+	var r0 *delivery.GetRequestActualInfoResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*delivery.GetRequestActualInfoResponse, error)); ok {
+		return rf(requestID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *delivery.GetRequestActualInfoResponse); ok {
+		r0 = rf(requestID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*delivery.GetRequestActualInfoResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeliveryClient_GetRequestActualInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRequestActualInfo'
+type DeliveryClient_GetRequestActualInfo_Call struct {
+	*mock.Call
+}
+
+// GetRequestActualInfo is a helper method to define mock.On call
+//   - requestID string
+func (_e *DeliveryClient_Expecter) GetRequestActualInfo(requestID interface{}) *DeliveryClient_GetRequestActualInfo_Call {
+	return &DeliveryClient_GetRequestActualInfo_Call{Call: _e.mock.On("GetRequestActualInfo", requestID)}
+}
+
+func (_c *DeliveryClient_GetRequestActualInfo_Call) Run(run func(requestID string)) *DeliveryClient_GetRequestActualInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *DeliveryClient_GetRequestActualInfo_Call) Return(_a0 *delivery.GetRequestActualInfoResponse, _a1 error) *DeliveryClient_GetRequestActualInfo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeliveryClient_GetRequestActualInfo_Call) RunAndReturn(run func(string) (*delivery.GetRequestActualInfoResponse, error)) *DeliveryClient_GetRequestActualInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRequestHandoverAct provides a mock function with given fields: requestIds
+func (_m *DeliveryClient) GetRequestHandoverAct(requestIds ...string) (io.ReadCloser, error) {
+	_va := make([]interface{}, len(requestIds))
+	for _i := range requestIds {
+		_va[_i] = requestIds[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRequestHandoverAct")
+	}
+
+	var r0 io.ReadCloser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...string) (io.ReadCloser, error)); ok {
+		return rf(requestIds...)
+	}
+	if rf, ok := ret.Get(0).(func(...string) io.ReadCloser); ok {
+		r0 = rf(requestIds...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...string) error); ok {
+		r1 = rf(requestIds...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeliveryClient_GetRequestHandoverAct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRequestHandoverAct'
+type DeliveryClient_GetRequestHandoverAct_Call struct {
+	*mock.Call
+}
+
+// GetRequestHandoverAct is a helper method to define mock.On call
+//   - requestIds ...string
+func (_e *DeliveryClient_Expecter) GetRequestHandoverAct(requestIds ...interface{}) *DeliveryClient_GetRequestHandoverAct_Call {
+	return &DeliveryClient_GetRequestHandoverAct_Call{Call: _e.mock.On("GetRequestHandoverAct",
+		append([]interface{}{}, requestIds...)...)}
+}
+
+func (_c *DeliveryClient_GetRequestHandoverAct_Call) Run(run func(requestIds ...string)) *DeliveryClient_GetRequestHandoverAct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *DeliveryClient_GetRequestHandoverAct_Call) Return(_a0 io.ReadCloser, _a1 error) *DeliveryClient_GetRequestHandoverAct_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeliveryClient_GetRequestHandoverAct_Call) RunAndReturn(run func(...string) (io.ReadCloser, error)) *DeliveryClient_GetRequestHandoverAct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRequestHistory provides a mock function with given fields: requestID
+func (_m *DeliveryClient) GetRequestHistory(requestID string) (*delivery.GetRequestHistoryResponse, error) {
+	ret := _m.Called(requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRequestHistory")
+	}
+
+	var r0 *delivery.GetRequestHistoryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*delivery.GetRequestHistoryResponse, error)); ok {
+		return rf(requestID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *delivery.GetRequestHistoryResponse); ok {
+		r0 = rf(requestID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*delivery.GetRequestHistoryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeliveryClient_GetRequestHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRequestHistory'
+type DeliveryClient_GetRequestHistory_Call struct {
+	*mock.Call
+}
+
+// GetRequestHistory is a helper method to define mock.On call
+//   - requestID string
+func (_e *DeliveryClient_Expecter) GetRequestHistory(requestID interface{}) *DeliveryClient_GetRequestHistory_Call {
+	return &DeliveryClient_GetRequestHistory_Call{Call: _e.mock.On("GetRequestHistory", requestID)}
+}
+
+func (_c *DeliveryClient_GetRequestHistory_Call) Run(run func(requestID string)) *DeliveryClient_GetRequestHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *DeliveryClient_GetRequestHistory_Call) Return(_a0 *delivery.GetRequestHistoryResponse, _a1 error) *DeliveryClient_GetRequestHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeliveryClient_GetRequestHistory_Call) RunAndReturn(run func(string) (*delivery.GetRequestHistoryResponse, error)) *DeliveryClient_GetRequestHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRequestInfo provides a mock function with given fields: requestID, slim
+func (_m *DeliveryClient) GetRequestInfo(requestID string, slim bool) (*delivery.GetRequestInfoResponse, error) {
+	ret := _m.Called(requestID, slim)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRequestInfo")
+	}
+
+	var r0 *delivery.GetRequestInfoResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, bool) (*delivery.GetRequestInfoResponse, error)); ok {
+		return rf(requestID, slim)
+	}
+	if rf, ok := ret.Get(0).(func(string, bool) *delivery.GetRequestInfoResponse); ok {
+		r0 = rf(requestID, slim)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*delivery.GetRequestInfoResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, bool) error); ok {
+		r1 = rf(requestID, slim)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeliveryClient_GetRequestInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRequestInfo'
+type DeliveryClient_GetRequestInfo_Call struct {
+	*mock.Call
+}
+
+// GetRequestInfo is a helper method to define mock.On call
+//   - requestID string
+//   - slim bool
+func (_e *DeliveryClient_Expecter) GetRequestInfo(requestID interface{}, slim interface{}) *DeliveryClient_GetRequestInfo_Call {
+	return &DeliveryClient_GetRequestInfo_Call{Call: _e.mock.On("GetRequestInfo", requestID, slim)}
+}
+
+func (_c *DeliveryClient_GetRequestInfo_Call) Run(run func(requestID string, slim bool)) *DeliveryClient_GetRequestInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(bool))
+	})
+	return _c
+}
+
+func (_c *DeliveryClient_GetRequestInfo_Call) Return(_a0 *delivery.GetRequestInfoResponse, _a1 error) *DeliveryClient_GetRequestInfo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeliveryClient_GetRequestInfo_Call) RunAndReturn(run func(string, bool) (*delivery.GetRequestInfoResponse, error)) *DeliveryClient_GetRequestInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRequestRedeliveryOptions provides a mock function with given fields: req
+func (_m *DeliveryClient) GetRequestRedeliveryOptions(req delivery.GetRequestRedeliveryOptionsRequest) (*delivery.GetRequestRedeliveryOptionsResponse, error) {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRequestRedeliveryOptions")
+	}
+
+	var r0 *delivery.GetRequestRedeliveryOptionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(delivery.GetRequestRedeliveryOptionsRequest) (*delivery.GetRequestRedeliveryOptionsResponse, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(delivery.GetRequestRedeliveryOptionsRequest) *delivery.GetRequestRedeliveryOptionsResponse); ok {
+		r0 = rf(req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*delivery.GetRequestRedeliveryOptionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(delivery.GetRequestRedeliveryOptionsRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeliveryClient_GetRequestRedeliveryOptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRequestRedeliveryOptions'
+type DeliveryClient_GetRequestRedeliveryOptions_Call struct {
+	*mock.Call
+}
+
+// GetRequestRedeliveryOptions is a helper method to define mock.On call
+//   - req delivery.GetRequestRedeliveryOptionsRequest
+func (_e *DeliveryClient_Expecter) GetRequestRedeliveryOptions(req interface{}) *DeliveryClient_GetRequestRedeliveryOptions_Call {
+	return &DeliveryClient_GetRequestRedeliveryOptions_Call{Call: _e.mock.On("GetRequestRedeliveryOptions", req)}
+}
+
+func (_c *DeliveryClient_GetRequestRedeliveryOptions_Call) Run(run func(req delivery.GetRequestRedeliveryOptionsRequest)) *DeliveryClient_GetRequestRedeliveryOptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(delivery.GetRequestRedeliveryOptionsRequest))
+	})
+	return _c
+}
+
+func (_c *DeliveryClient_GetRequestRedeliveryOptions_Call) Return(_a0 *delivery.GetRequestRedeliveryOptionsResponse, _a1 error) *DeliveryClient_GetRequestRedeliveryOptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeliveryClient_GetRequestRedeliveryOptions_Call) RunAndReturn(run func(delivery.GetRequestRedeliveryOptionsRequest) (*delivery.GetRequestRedeliveryOptionsResponse, error)) *DeliveryClient_GetRequestRedeliveryOptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRequestsInfo provides a mock function with given fields: from, to, requestsIds
+func (_m *DeliveryClient) GetRequestsInfo(from time.Time, to time.Time, requestsIds ...string) (*delivery.GetRequestsInfoResponse, error) {
+	_va := make([]interface{}, len(requestsIds))
+	for _i := range requestsIds {
+		_va[_i] = requestsIds[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, from, to)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRequestsInfo")
+	}
+
+	var r0 *delivery.GetRequestsInfoResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time, time.Time, ...string) (*delivery.GetRequestsInfoResponse, error)); ok {
+		return rf(from, to, requestsIds...)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time, time.Time, ...string) *delivery.GetRequestsInfoResponse); ok {
+		r0 = rf(from, to, requestsIds...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*delivery.GetRequestsInfoResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time, time.Time, ...string) error); ok {
+		r1 = rf(from, to, requestsIds...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeliveryClient_GetRequestsInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRequestsInfo'
+type DeliveryClient_GetRequestsInfo_Call struct {
+	*mock.Call
+}
+
+// GetRequestsInfo is a helper method to define mock.On call
+//   - from time.Time
+//   - to time.Time
+//   - requestsIds ...string
+func (_e *DeliveryClient_Expecter) GetRequestsInfo(from interface{}, to interface{}, requestsIds ...interface{}) *DeliveryClient_GetRequestsInfo_Call {
+	return &DeliveryClient_GetRequestsInfo_Call{Call: _e.mock.On("GetRequestsInfo",
+		append([]interface{}{from, to}, requestsIds...)...)}
+}
+
+func (_c *DeliveryClient_GetRequestsInfo_Call) Run(run func(from time.Time, to time.Time, requestsIds ...string)) *DeliveryClient_GetRequestsInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(args[0].(time.Time), args[1].(time.Time), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *DeliveryClient_GetRequestsInfo_Call) Return(_a0 *delivery.GetRequestsInfoResponse, _a1 error) *DeliveryClient_GetRequestsInfo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeliveryClient_GetRequestsInfo_Call) RunAndReturn(run func(time.Time, time.Time, ...string) (*delivery.GetRequestsInfoResponse, error)) *DeliveryClient_GetRequestsInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithContext provides a mock function with given fields: ctx
+func (_m *DeliveryClient) WithContext(ctx context.Context) delivery.DeliveryClient {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithContext")
+	}
+
+	var r0 delivery.DeliveryClient
+	if rf, ok := ret.Get(0).(func(context.Context) delivery.DeliveryClient); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(delivery.DeliveryClient)
+		}
+	}
+
+	return r0
+}
+
+// DeliveryClient_WithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithContext'
+type DeliveryClient_WithContext_Call struct {
+	*mock.Call
+}
+
+// WithContext is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *DeliveryClient_Expecter) WithContext(ctx interface{}) *DeliveryClient_WithContext_Call {
+	return &DeliveryClient_WithContext_Call{Call: _e.mock.On("WithContext", ctx)}
+}
+
+func (_c *DeliveryClient_WithContext_Call) Run(run func(ctx context.Context)) *DeliveryClient_WithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *DeliveryClient_WithContext_Call) Return(_a0 delivery.DeliveryClient) *DeliveryClient_WithContext_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DeliveryClient_WithContext_Call) RunAndReturn(run func(context.Context) delivery.DeliveryClient) *DeliveryClient_WithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithOversizedDetection provides a mock function with given fields: limits
+func (_m *DeliveryClient) WithOversizedDetection(limits delivery.OversizedLimits) delivery.DeliveryClient {
+	ret := _m.Called(limits)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithOversizedDetection")
+	}
+
+	var r0 delivery.DeliveryClient
+	if rf, ok := ret.Get(0).(func(delivery.OversizedLimits) delivery.DeliveryClient); ok {
+		r0 = rf(limits)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(delivery.DeliveryClient)
+		}
+	}
+
+	return r0
+}
+
+// DeliveryClient_WithOversizedDetection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithOversizedDetection'
+type DeliveryClient_WithOversizedDetection_Call struct {
+	*mock.Call
+}
+
+// WithOversizedDetection is a helper method to define mock.On call
+//   - limits delivery.OversizedLimits
+func (_e *DeliveryClient_Expecter) WithOversizedDetection(limits interface{}) *DeliveryClient_WithOversizedDetection_Call {
+	return &DeliveryClient_WithOversizedDetection_Call{Call: _e.mock.On("WithOversizedDetection", limits)}
+}
+
+func (_c *DeliveryClient_WithOversizedDetection_Call) Run(run func(limits delivery.OversizedLimits)) *DeliveryClient_WithOversizedDetection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(delivery.OversizedLimits))
+	})
+	return _c
+}
+
+func (_c *DeliveryClient_WithOversizedDetection_Call) Return(_a0 delivery.DeliveryClient) *DeliveryClient_WithOversizedDetection_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DeliveryClient_WithOversizedDetection_Call) RunAndReturn(run func(delivery.OversizedLimits) delivery.DeliveryClient) *DeliveryClient_WithOversizedDetection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithPointsFilter provides a mock function with given fields: filter
+func (_m *DeliveryClient) WithPointsFilter(filter func(delivery.Point) bool) delivery.DeliveryClient {
+	ret := _m.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithPointsFilter")
+	}
+
+	var r0 delivery.DeliveryClient
+	if rf, ok := ret.Get(0).(func(func(delivery.Point) bool) delivery.DeliveryClient); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(delivery.DeliveryClient)
+		}
+	}
+
+	return r0
+}
+
+// DeliveryClient_WithPointsFilter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithPointsFilter'
+type DeliveryClient_WithPointsFilter_Call struct {
+	*mock.Call
+}
+
+// WithPointsFilter is a helper method to define mock.On call
+//   - filter func(delivery.Point) bool
+func (_e *DeliveryClient_Expecter) WithPointsFilter(filter interface{}) *DeliveryClient_WithPointsFilter_Call {
+	return &DeliveryClient_WithPointsFilter_Call{Call: _e.mock.On("WithPointsFilter", filter)}
+}
+
+func (_c *DeliveryClient_WithPointsFilter_Call) Run(run func(filter func(delivery.Point) bool)) *DeliveryClient_WithPointsFilter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(func(delivery.Point) bool))
+	})
+	return _c
+}
+
+func (_c *DeliveryClient_WithPointsFilter_Call) Return(_a0 delivery.DeliveryClient) *DeliveryClient_WithPointsFilter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DeliveryClient_WithPointsFilter_Call) RunAndReturn(run func(func(delivery.Point) bool) delivery.DeliveryClient) *DeliveryClient_WithPointsFilter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithSourceStation provides a mock function with given fields: stationID
+func (_m *DeliveryClient) WithSourceStation(stationID string) delivery.DeliveryClient {
+	ret := _m.Called(stationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithSourceStation")
+	}
+
+	var r0 delivery.DeliveryClient
+	if rf, ok := ret.Get(0).(func(string) delivery.DeliveryClient); ok {
+		r0 = rf(stationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(delivery.DeliveryClient)
+		}
+	}
+
+	return r0
+}
+
+// DeliveryClient_WithSourceStation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithSourceStation'
+type DeliveryClient_WithSourceStation_Call struct {
+	*mock.Call
+}
+
+// WithSourceStation is a helper method to define mock.On call
+//   - stationID string
+func (_e *DeliveryClient_Expecter) WithSourceStation(stationID interface{}) *DeliveryClient_WithSourceStation_Call {
+	return &DeliveryClient_WithSourceStation_Call{Call: _e.mock.On("WithSourceStation", stationID)}
+}
+
+func (_c *DeliveryClient_WithSourceStation_Call) Run(run func(stationID string)) *DeliveryClient_WithSourceStation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *DeliveryClient_WithSourceStation_Call) Return(_a0 delivery.DeliveryClient) *DeliveryClient_WithSourceStation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DeliveryClient_WithSourceStation_Call) RunAndReturn(run func(string) delivery.DeliveryClient) *DeliveryClient_WithSourceStation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithUnixTime provides a mock function with given fields: enabled
+func (_m *DeliveryClient) WithUnixTime(enabled bool) delivery.DeliveryClient {
+	ret := _m.Called(enabled)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithUnixTime")
+	}
+
+	var r0 delivery.DeliveryClient
+	if rf, ok := ret.Get(0).(func(bool) delivery.DeliveryClient); ok {
+		r0 = rf(enabled)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(delivery.DeliveryClient)
+		}
+	}
+
+	return r0
+}
+
+// DeliveryClient_WithUnixTime_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithUnixTime'
+type DeliveryClient_WithUnixTime_Call struct {
+	*mock.Call
+}
+
+// WithUnixTime is a helper method to define mock.On call
+//   - enabled bool
+func (_e *DeliveryClient_Expecter) WithUnixTime(enabled interface{}) *DeliveryClient_WithUnixTime_Call {
+	return &DeliveryClient_WithUnixTime_Call{Call: _e.mock.On("WithUnixTime", enabled)}
+}
+
+func (_c *DeliveryClient_WithUnixTime_Call) Run(run func(enabled bool)) *DeliveryClient_WithUnixTime_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(bool))
+	})
+	return _c
+}
+
+func (_c *DeliveryClient_WithUnixTime_Call) Return(_a0 delivery.DeliveryClient) *DeliveryClient_WithUnixTime_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DeliveryClient_WithUnixTime_Call) RunAndReturn(run func(bool) delivery.DeliveryClient) *DeliveryClient_WithUnixTime_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDeliveryClient creates a new instance of DeliveryClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDeliveryClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DeliveryClient {
+	mock := &DeliveryClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
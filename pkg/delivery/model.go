@@ -25,6 +25,17 @@ const (
 	ERS_Success   EditingRequestStatus = "success"
 	ERS_Failure   EditingRequestStatus = "failure"
 
+	UT_RecipientInfo  UpdateType = "recipient_info"   // Данные получателя
+	UT_Destination    UpdateType = "destination"      // Место получения заказа
+	UT_DeliveryDates  UpdateType = "delivery_dates"   // Интервал доставки
+	UT_LastMilePolicy UpdateType = "last_mile_policy" // Способ доставки
+	UT_Places         UpdateType = "places"           // Грузоместа
+
+	US_Pending   UpdateStatus = "pending"   // Ожидает выполнения
+	US_Execution UpdateStatus = "execution" // Выполняется
+	US_Success   UpdateStatus = "success"   // Выполнено
+	US_Failure   UpdateStatus = "failure"   // Не выполнено
+
 	R_Cancel_ShopCanceled               Reason = "SHOP_CANCELLED"                // Отправитель отменил заказ
 	R_Cancel_UserChangedMind            Reason = "USER_CHANGED_MIND"             // Покупатель передумал
 	R_Cancel_DeliveryProblems           Reason = "DELIVERY_PROBLEMS"             // Проблемы с доставкой
@@ -58,6 +69,8 @@ type (
 	LastMilePolicy       string // Варианты доставки
 	PickupStationType    string // Тип точки приема/выдачи заказа.
 	EditingRequestStatus string // Статус запроса на редактирование
+	UpdateType           string // Тип изменения заказа
+	UpdateStatus         string // Статус изменения заказа
 	Reason               string // Описание причины переноса/отмены
 )
 
@@ -244,7 +257,7 @@ type Contact struct {
 }
 
 type Dayoff struct {
-	Date string `json:"date_utc"` // Дата в формате UTC
+	Date Timestamp `json:"date_utc"` // Дата в формате UTC
 }
 
 type Position struct {
@@ -335,8 +348,8 @@ type ConfirmOfferResponse struct {
 type GetRequestInfoResponse RequestElement
 
 type ActualDeliveryInterval struct {
-	From string `json:"from"` // "10:00+03:00"
-	To   string `json:"to"`   // "11:00+03:00"
+	From Timestamp `json:"from"` // "10:00+03:00"
+	To   Timestamp `json:"to"`   // "11:00+03:00"
 }
 
 type AvailableActions struct {
@@ -355,28 +368,19 @@ type IntervalUTC struct {
 	To   time.Time `json:"to"`
 }
 
-// UnmarshalJSON parses JSON string to IntervalUTC
+// UnmarshalJSON parses JSON string to IntervalUTC.
+// Accepts any format supported by Timestamp
 func (i *IntervalUTC) UnmarshalJSON(data []byte) error {
-	// Create a temporary structure to avoid recursion
-	var raw map[string]string
-	if err := json.Unmarshal(data, &raw); err != nil {
-		return err
+	var raw struct {
+		From Timestamp `json:"from"`
+		To   Timestamp `json:"to"`
 	}
-
-	// Parse the "from" time
-	from, err := time.Parse(customTimeLayout, raw["from"])
-	if err != nil {
-		return err
-	}
-	i.From = from
-
-	// Parse the "to" time
-	to, err := time.Parse(customTimeLayout, raw["to"])
-	if err != nil {
+	if err := json.Unmarshal(data, &raw); err != nil {
 		return err
 	}
-	i.To = to
 
+	i.From = raw.From.Time
+	i.To = raw.To.Time
 	return nil
 }
 
@@ -413,7 +417,7 @@ type Item struct {
 type State struct {
 	Status       string    `json:"status"`        // Статус, описывающий текущее состояние заказа
 	Description  string    `json:"description"`   // Описание статуса
-	TimestampUTC time.Time `json:"timestamp_utc"` // Временная метка в формате UTC
+	TimestampUTC Timestamp `json:"timestamp_utc"` // Временная метка в формате UTC
 	Reason       Reason    `json:"reason"`
 }
 
@@ -450,7 +454,7 @@ type RequestInfo struct {
 }
 
 type GetRequestActualInfoResponse struct {
-	DeliveryDate     string           `json:"delivery_date"`     // Дата доставки
+	DeliveryDate     Timestamp        `json:"delivery_date"`     // Дата доставки
 	DeliveryInterval DeliveryInterval `json:"delivery_interval"` // Интервал доставки
 }
 
@@ -475,11 +479,11 @@ type EditRequestInfoResponse struct {
 }
 
 type Update struct {
-	Reason       string   `json:"reason"`        // Причина формирования статуса
-	Status       string   `json:"status"`        // Статус обновления
-	Type         string   `json:"type"`          // Тип изменения
-	ErrorDetails []string `json:"error_details"` // Описание ошибок
-	Code         string   `json:"code"`          // Кол статуса
+	Reason       string       `json:"reason"`        // Причина формирования статуса
+	Status       UpdateStatus `json:"status"`        // Статус обновления
+	Type         UpdateType   `json:"type"`          // Тип изменения
+	ErrorDetails []string     `json:"error_details"` // Описание ошибок
+	Code         string       `json:"code"`          // Кол статуса
 }
 
 type GetRequestRedeliveryOptionsRequest struct {
@@ -498,10 +502,10 @@ type GetRequestHistoryResponse struct {
 }
 
 type StateHistory struct {
-	Status       string `json:"status"`        // Статус, описывающий текущее состояние заказа
-	Description  string `json:"description"`   // Описание статуса
-	TimestampUTC string `json:"timestamp_utc"` // Временная метка в формате UTC
-	Reason       Reason `json:"reason"`        // Причина изменения статуса
+	Status       string    `json:"status"`        // Статус, описывающий текущее состояние заказа
+	Description  string    `json:"description"`   // Описание статуса
+	TimestampUTC Timestamp `json:"timestamp_utc"` // Временная метка в формате UTC
+	Reason       Reason    `json:"reason"`        // Причина изменения статуса
 }
 
 type CancelRequestResponse struct {
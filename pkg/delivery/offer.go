@@ -0,0 +1,133 @@
+package delivery
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrOfferExpired = errors.New("offer expired and no equivalent offer found")
+	ErrInvalidPrice = errors.New("invalid price")
+)
+
+// OfferSet набор офферов, полученных по одному запросу CreateOffer
+type OfferSet struct {
+	delivery DeliveryClient
+	request  CreateOfferRequest
+	offers   []OfferItem
+}
+
+// CreateOfferSet создает офферы и возвращает их в виде набора
+func CreateOfferSet(client DeliveryClient, req CreateOfferRequest) (*OfferSet, error) {
+	resp, err := client.CreateOffer(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewOfferSet(client, req, resp.Offers), nil
+}
+
+// NewOfferSet создает набор из уже полученных офферов.
+// Подтверждение оффера выполняется через переданный клиент
+func NewOfferSet(client DeliveryClient, req CreateOfferRequest, offers []OfferItem) *OfferSet {
+	return &OfferSet{delivery: client, request: req, offers: offers}
+}
+
+// Offers возвращает офферы набора
+func (s *OfferSet) Offers() []OfferItem {
+	return s.offers
+}
+
+// SortByPrice сортирует офферы по возрастанию полной стоимости доставки.
+// Офферы с некорректной стоимостью располагаются в конце
+func (s *OfferSet) SortByPrice() *OfferSet {
+	slices.SortStableFunc(s.offers, func(a, b OfferItem) int {
+		ap, aErr := parsePrice(a.OfferDetails.PricingTotal)
+		bp, bErr := parsePrice(b.OfferDetails.PricingTotal)
+		switch {
+		case aErr != nil || bErr != nil:
+			return cmp.Compare(errRank(aErr), errRank(bErr))
+		case ap < bp:
+			return -1
+		case ap > bp:
+			return 1
+		default:
+			return 0
+		}
+	})
+	return s
+}
+
+// errRank возвращает 1 для значения, которое не удалось разобрать
+func errRank(err error) int {
+	if err != nil {
+		return 1
+	}
+	return 0
+}
+
+// SortByTime сортирует офферы по началу интервала доставки
+func (s *OfferSet) SortByTime() *OfferSet {
+	slices.SortStableFunc(s.offers, func(a, b OfferItem) int {
+		return a.OfferDetails.DeliveryInterval.Min.Compare(b.OfferDetails.DeliveryInterval.Min)
+	})
+	return s
+}
+
+// Valid возвращает офферы, срок действия которых не истек на момент now
+func (s *OfferSet) Valid(now time.Time) []OfferItem {
+	valid := make([]OfferItem, 0, len(s.offers))
+	for _, offer := range s.offers {
+		if offer.ExpiresAt.After(now) {
+			valid = append(valid, offer)
+		}
+	}
+	return valid
+}
+
+// Confirm подтверждает оффер.
+// Если срок действия оффера истек, офферы запрашиваются повторно
+// и подтверждается действующий оффер с тем же интервалом и политикой доставки
+func (s *OfferSet) Confirm(offer OfferItem) (*ConfirmOfferResponse, error) {
+	now := time.Now()
+	if !offer.ExpiresAt.After(now) {
+		resp, err := s.delivery.CreateOffer(s.request)
+		if err != nil {
+			return nil, err
+		}
+
+		s.offers = resp.Offers
+
+		idx := slices.IndexFunc(s.offers, func(o OfferItem) bool {
+			return o.ExpiresAt.After(now) && o.OfferDetails.DeliveryInterval.equal(offer.OfferDetails.DeliveryInterval)
+		})
+		if idx < 0 {
+			return nil, ErrOfferExpired
+		}
+
+		offer = s.offers[idx]
+	}
+
+	return s.delivery.ConfirmOffer(offer.OfferID)
+}
+
+func (i DeliveryInterval) equal(o DeliveryInterval) bool {
+	return i.Min.Equal(o.Min) && i.Max.Equal(o.Max) && i.Policy == o.Policy
+}
+
+// parsePrice разбирает стоимость из ответа API, например "299.5 RUB".
+// Ошибка разбора соответствует ErrInvalidPrice
+func parsePrice(price string) (float64, error) {
+	value, _, _ := strings.Cut(strings.TrimSpace(price), " ")
+
+	amount, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidPrice, price)
+	}
+	return amount, nil
+}
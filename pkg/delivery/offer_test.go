@@ -0,0 +1,98 @@
+package delivery_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/delivery"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOfferSet(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	interval := func(h int) delivery.DeliveryInterval {
+		return delivery.DeliveryInterval{
+			Min:    now.Add(time.Duration(h) * time.Hour),
+			Max:    now.Add(time.Duration(h+2) * time.Hour),
+			Policy: string(delivery.LMP_TimeInterval),
+		}
+	}
+	offer := func(id, price string, h int, expires time.Time) delivery.OfferItem {
+		return delivery.OfferItem{
+			OfferID:   id,
+			ExpiresAt: expires,
+			OfferDetails: delivery.OfferDetails{
+				PricingTotal:     price,
+				DeliveryInterval: interval(h),
+			},
+		}
+	}
+
+	created := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/offers/create", func(w http.ResponseWriter, r *http.Request) {
+		created++
+
+		expires := now.Add(-time.Minute)
+		if created > 1 {
+			expires = now.Add(time.Hour)
+		}
+
+		json.NewEncoder(w).Encode(delivery.CreateOfferResponse{Offers: []delivery.OfferItem{
+			offer("late", "100 RUB", 48, expires),
+			offer("cheap", "99.5 RUB", 72, now.Add(time.Hour)),
+			offer("early", "300 RUB", 24, expires),
+			offer("broken", "n/a", 12, now.Add(time.Hour)),
+			offer("stale", "50 RUB", 96, now.Add(-time.Minute)),
+		}})
+	})
+	mux.HandleFunc("/offers/confirm", func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]string{}
+		json.NewDecoder(r.Body).Decode(&body)
+		json.NewEncoder(w).Encode(delivery.ConfirmOfferResponse{RequestID: "req-" + body["offer_id"]})
+	})
+	d := newTestDelivery(t, mux)
+
+	set, err := delivery.CreateOfferSet(d, delivery.CreateOfferRequest{})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	ids := func(offers []delivery.OfferItem) (res []string) {
+		for _, o := range offers {
+			res = append(res, o.OfferID)
+		}
+		return res
+	}
+
+	assert.Equal(t, []string{"stale", "cheap", "late", "early", "broken"}, ids(set.SortByPrice().Offers()))
+	assert.Equal(t, []string{"broken", "early", "late", "cheap", "stale"}, ids(set.SortByTime().Offers()))
+	assert.Equal(t, []string{"broken", "cheap"}, ids(set.Valid(now)))
+
+	t.Run("valid offer", func(t *testing.T) {
+		resp, err := set.Confirm(set.Valid(now)[1])
+		assert.NoError(t, err)
+		assert.Equal(t, "req-cheap", resp.RequestID)
+		assert.Equal(t, 1, created)
+	})
+
+	t.Run("expired offer is refreshed", func(t *testing.T) {
+		resp, err := set.Confirm(set.Offers()[1])
+		assert.NoError(t, err)
+		assert.Equal(t, "req-early", resp.RequestID)
+		assert.Equal(t, 2, created)
+	})
+
+	t.Run("equivalent offer is expired", func(t *testing.T) {
+		_, err := set.Confirm(offer("stale", "50 RUB", 96, now.Add(-time.Minute)))
+		assert.ErrorIs(t, err, delivery.ErrOfferExpired)
+		assert.Equal(t, 3, created)
+	})
+
+	t.Run("no equivalent offer", func(t *testing.T) {
+		_, err := set.Confirm(offer("gone", "1 RUB", 1, now.Add(-time.Hour)))
+		assert.ErrorIs(t, err, delivery.ErrOfferExpired)
+	})
+}
@@ -0,0 +1,86 @@
+package delivery
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// EditOutcome итог запроса на изменение заказа
+type EditOutcome struct {
+	EditID    string   // ID операции редактирования
+	Completed []Update // Выполненные изменения
+	Active    []Update // Выполняющиеся изменения
+	Ignored   []Update // Невыполненные изменения
+}
+
+// Outcome возвращает итог запроса на изменение заказа
+func (r *EditRequestInfoResponse) Outcome() EditOutcome {
+	return EditOutcome{
+		EditID:    r.EditID,
+		Completed: r.CompletedUpdates,
+		Active:    r.ActiveUpdates,
+		Ignored:   r.IgnoredUpdates,
+	}
+}
+
+// AllApplied сообщает, что все изменения выполнены
+func (o EditOutcome) AllApplied() bool {
+	return len(o.Active) == 0 && len(o.Failed()) == 0
+}
+
+// InProgress сообщает, что часть изменений еще выполняется.
+// Дождаться их завершения можно через GetEditRequestStatus с EditID
+func (o EditOutcome) InProgress() bool {
+	return len(o.Active) > 0
+}
+
+// Failed возвращает невыполненные изменения: все изменения из Ignored
+// и изменения со статусом US_Failure из Completed и Active.
+// Причина указана в Reason и ErrorDetails каждого изменения
+func (o EditOutcome) Failed() []Update {
+	failed := slices.Clone(o.Ignored)
+	for _, update := range slices.Concat(o.Completed, o.Active) {
+		if update.Status == US_Failure {
+			failed = append(failed, update)
+		}
+	}
+
+	return failed
+}
+
+// Err возвращает EditError с описанием невыполненных изменений
+// или nil, если таких изменений нет
+func (o EditOutcome) Err() error {
+	failed := o.Failed()
+	if len(failed) == 0 {
+		return nil
+	}
+
+	return &EditError{EditID: o.EditID, Updates: failed}
+}
+
+// EditError описывает изменения заказа, которые не были выполнены.
+// Соответствует ErrEditFailed
+type EditError struct {
+	EditID  string   // ID операции редактирования
+	Updates []Update // Невыполненные изменения
+}
+
+func (e *EditError) Error() string {
+	updates := make([]string, 0, len(e.Updates))
+	for _, update := range e.Updates {
+		reason := update.Reason
+		if len(update.ErrorDetails) > 0 {
+			reason = strings.TrimSpace(reason + " (" + strings.Join(update.ErrorDetails, "; ") + ")")
+		}
+
+		updates = append(updates, fmt.Sprintf("%v: %v", update.Type, reason))
+	}
+
+	return fmt.Sprintf("%v: %v", ErrEditFailed, strings.Join(updates, ", "))
+}
+
+func (e *EditError) Is(target error) bool {
+	return target == ErrEditFailed
+}
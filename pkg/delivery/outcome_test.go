@@ -0,0 +1,79 @@
+package delivery_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/delivery"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEditOutcome(t *testing.T) {
+	resp := delivery.EditRequestInfoResponse{}
+	err := json.Unmarshal([]byte(`{
+		"edit_id": "edit",
+		"completed_updates": [{"type": "recipient_info", "status": "success"}],
+		"active_updates": [],
+		"ignored_updates": [
+			{"type": "destination", "status": "failure", "reason": "not available", "error_details": ["point is closed", "locker is full"]},
+			{"type": "places", "status": "failure", "reason": "too late"}
+		]
+	}`), &resp)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	outcome := resp.Outcome()
+	assert.False(t, outcome.AllApplied())
+	assert.False(t, outcome.InProgress())
+	assert.Equal(t, delivery.UT_RecipientInfo, outcome.Completed[0].Type)
+
+	ignored := outcome.Ignored
+	if assert.Len(t, ignored, 2) {
+		assert.Equal(t, delivery.UT_Destination, ignored[0].Type)
+		assert.Equal(t, delivery.US_Failure, ignored[0].Status)
+	}
+
+	err = outcome.Err()
+	assert.ErrorIs(t, err, delivery.ErrEditFailed)
+	assert.EqualError(t, err, "request edit failed: destination: not available (point is closed; locker is full), places: too late")
+
+	editErr := &delivery.EditError{}
+	if assert.True(t, errors.As(err, &editErr)) {
+		assert.Equal(t, "edit", editErr.EditID)
+	}
+}
+
+func TestEditOutcome_AllApplied(t *testing.T) {
+	resp := delivery.EditRequestInfoResponse{
+		CompletedUpdates: []delivery.Update{{Type: delivery.UT_Places, Status: delivery.US_Success}},
+	}
+	assert.True(t, resp.Outcome().AllApplied())
+	assert.NoError(t, resp.Outcome().Err())
+
+	resp.ActiveUpdates = []delivery.Update{{Type: delivery.UT_DeliveryDates, Status: delivery.US_Execution}}
+	assert.False(t, resp.Outcome().AllApplied())
+	assert.True(t, resp.Outcome().InProgress())
+	assert.NoError(t, resp.Outcome().Err())
+}
+
+func TestEditOutcome_Failed(t *testing.T) {
+	resp := delivery.EditRequestInfoResponse{
+		EditID: "edit",
+		CompletedUpdates: []delivery.Update{
+			{Type: delivery.UT_RecipientInfo, Status: delivery.US_Success},
+			{Type: delivery.UT_Destination, Status: delivery.US_Failure, Reason: "closed"},
+		},
+	}
+
+	outcome := resp.Outcome()
+	assert.False(t, outcome.AllApplied())
+	assert.False(t, outcome.InProgress())
+	assert.Empty(t, outcome.Ignored)
+	assert.Len(t, outcome.Failed(), 1)
+
+	err := outcome.Err()
+	assert.ErrorIs(t, err, delivery.ErrEditFailed)
+	assert.EqualError(t, err, "request edit failed: destination: closed")
+}
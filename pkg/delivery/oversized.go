@@ -0,0 +1,91 @@
+package delivery
+
+import (
+	"fmt"
+	"slices"
+)
+
+// DefaultOversizedLimits ограничения для обычных отправлений Яндекс Доставки.
+// Грузоместо, превышающее любое из них, считается крупногабаритным (КГТ)
+var DefaultOversizedLimits = OversizedLimits{
+	MaxWeight:  30000,
+	MaxSide:    120,
+	MaxSideSum: 200,
+}
+
+// OversizedLimits ограничения грузоместа, при превышении которых отправление считается КГТ.
+// Нулевое значение ограничения не проверяется
+type OversizedLimits struct {
+	MaxWeight  float64 // Максимальный вес брутто, граммы
+	MaxSide    float64 // Максимальная длина стороны, сантиметры
+	MaxSideSum float64 // Максимальная сумма трех измерений, сантиметры
+}
+
+// OversizedReport результат проверки грузомест на КГТ
+type OversizedReport struct {
+	Oversized bool          // Хотя бы одно грузоместо крупногабаритное
+	Places    []PlaceReport // Результаты проверки грузомест в порядке передачи
+}
+
+// PlaceReport результат проверки грузоместа на КГТ
+type PlaceReport struct {
+	Barcode   string   // Штрихкод грузоместа
+	Oversized bool     // Грузоместо крупногабаритное
+	Reasons   []string // Превышенные ограничения
+}
+
+// Classify проверяет грузоместа на превышение ограничений
+func (l OversizedLimits) Classify(places []Place) OversizedReport {
+	report := OversizedReport{Places: make([]PlaceReport, 0, len(places))}
+
+	for _, place := range places {
+		dims := place.PhysicalDims
+		sides := []float64{dims.Dx, dims.Dy, dims.Dz}
+		res := PlaceReport{Barcode: place.Barcode}
+
+		if l.MaxWeight > 0 && dims.WeightGross > l.MaxWeight {
+			res.Reasons = append(res.Reasons, fmt.Sprintf("weight %v g exceeds %v g", dims.WeightGross, l.MaxWeight))
+		}
+
+		if side := slices.Max(sides); l.MaxSide > 0 && side > l.MaxSide {
+			res.Reasons = append(res.Reasons, fmt.Sprintf("side %v cm exceeds %v cm", side, l.MaxSide))
+		}
+
+		if sum := sides[0] + sides[1] + sides[2]; l.MaxSideSum > 0 && sum > l.MaxSideSum {
+			res.Reasons = append(res.Reasons, fmt.Sprintf("sum of sides %v cm exceeds %v cm", sum, l.MaxSideSum))
+		}
+
+		res.Oversized = len(res.Reasons) > 0
+		report.Oversized = report.Oversized || res.Oversized
+		report.Places = append(report.Places, res)
+	}
+
+	return report
+}
+
+// IsOversized проверяет грузоместа на КГТ по ограничениям DefaultOversizedLimits
+func IsOversized(places []Place) bool {
+	return DefaultOversizedLimits.Classify(places).Oversized
+}
+
+// WithOversizedDetection возвращает копию клиента, которая определяет флаг КГТ
+// в GetPredictedPrice и GetDeliveryIntervals по грузоместам запроса.
+// Переданный в метод флаг isOversized учитывается: если он true, отправление считается КГТ.
+// Нулевые ограничения отключают определение
+func (d *Delivery) WithOversizedDetection(limits OversizedLimits) DeliveryClient {
+	c := *d
+	c.oversized = nil
+	if limits != (OversizedLimits{}) {
+		c.oversized = &limits
+	}
+	return &c
+}
+
+// isOversized возвращает флаг КГТ с учетом автоматического определения
+func (d *Delivery) isOversized(isOversized bool, places []Place) bool {
+	if isOversized || d.oversized == nil {
+		return isOversized
+	}
+
+	return d.oversized.Classify(places).Oversized
+}
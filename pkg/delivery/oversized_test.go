@@ -0,0 +1,74 @@
+package delivery_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/delivery"
+	"github.com/stretchr/testify/assert"
+)
+
+func place(barcode string, dx, dy, dz, weight float64) delivery.Place {
+	return delivery.Place{
+		Barcode:      barcode,
+		PhysicalDims: delivery.PhysicalDims{Dx: dx, Dy: dy, Dz: dz, WeightGross: weight},
+	}
+}
+
+func TestOversizedLimits_Classify(t *testing.T) {
+	report := delivery.DefaultOversizedLimits.Classify([]delivery.Place{
+		place("regular", 40, 30, 30, 5000),
+		place("heavy", 40, 30, 30, 35000),
+		place("long", 150, 20, 40, 5000),
+	})
+
+	assert.True(t, report.Oversized)
+	if assert.Len(t, report.Places, 3) {
+		assert.False(t, report.Places[0].Oversized)
+		assert.Empty(t, report.Places[0].Reasons)
+
+		assert.Equal(t, []string{"weight 35000 g exceeds 30000 g"}, report.Places[1].Reasons)
+
+		assert.Equal(t, "long", report.Places[2].Barcode)
+		assert.Equal(t, []string{
+			"side 150 cm exceeds 120 cm",
+			"sum of sides 210 cm exceeds 200 cm",
+		}, report.Places[2].Reasons)
+	}
+
+	assert.False(t, delivery.IsOversized([]delivery.Place{place("regular", 40, 30, 30, 5000)}))
+	assert.False(t, delivery.OversizedLimits{}.Classify([]delivery.Place{place("heavy", 40, 30, 30, 35000)}).Oversized)
+}
+
+func TestDelivery_WithOversizedDetection(t *testing.T) {
+	var flags []string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/pricing-calculator", func(w http.ResponseWriter, r *http.Request) {
+		flags = append(flags, r.URL.Query().Get("is_oversized"))
+		json.NewEncoder(w).Encode(delivery.PredictPriceResponse{})
+	})
+	mux.HandleFunc("/offers/info", func(w http.ResponseWriter, r *http.Request) {
+		flags = append(flags, r.URL.Query().Get("is_oversized"))
+		json.NewEncoder(w).Encode(delivery.DeliveryIntervalsResponse{})
+	})
+	d := newTestDelivery(t, mux)
+
+	heavy := []delivery.Place{place("heavy", 40, 30, 30, 35000)}
+
+	_, err := d.GetPredictedPrice(false, delivery.PredictPriceRequest{Places: heavy})
+	assert.NoError(t, err)
+
+	auto := d.WithOversizedDetection(delivery.DefaultOversizedLimits)
+	_, err = auto.GetPredictedPrice(false, delivery.PredictPriceRequest{Places: heavy})
+	assert.NoError(t, err)
+	_, err = auto.GetDeliveryIntervals(false, delivery.LMP_TimeInterval, delivery.DeliveryIntervalsRequest{Places: heavy})
+	assert.NoError(t, err)
+	_, err = auto.GetDeliveryIntervals(false, delivery.LMP_TimeInterval, delivery.DeliveryIntervalsRequest{
+		Places: []delivery.Place{place("regular", 40, 30, 30, 5000)},
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"false", "true", "true", "false"}, flags)
+}
@@ -0,0 +1,167 @@
+package delivery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+var (
+	ErrNoRedeliveryOptions = errors.New("no redelivery options")
+	ErrEditFailed          = errors.New("request edit failed")
+	ErrNoEditID            = errors.New("request edit is in progress without edit id")
+	ErrEditTimeout         = errors.New("request edit timeout")
+	ErrUnknownEditStatus   = errors.New("unknown request edit status")
+)
+
+// IntervalChooser выбирает интервал доставки из предложенных вариантов
+type IntervalChooser func(options []IntervalUTC) (IntervalUTC, error)
+
+// RescheduleOptions настройки переноса доставки
+type RescheduleOptions struct {
+	// Выбирает интервал из вариантов GetRequestRedeliveryOptions.
+	// По умолчанию выбирается первый из предложенных интервалов
+	Chooser IntervalChooser
+
+	// Интервал опроса статуса запроса на редактирование.
+	// Значение по умолчанию: 1 секунда
+	PollInterval time.Duration
+
+	// Максимальное время ожидания завершения запроса на редактирование.
+	// Значение по умолчанию: 2 минуты
+	Timeout time.Duration
+}
+
+func (o RescheduleOptions) withDefaults() RescheduleOptions {
+	if o.Chooser == nil {
+		o.Chooser = firstInterval
+	}
+	if o.PollInterval <= 0 {
+		o.PollInterval = time.Second
+	}
+	if o.Timeout <= 0 {
+		o.Timeout = 2 * time.Minute
+	}
+	return o
+}
+
+// Reschedule переносит доставку заказа на интервал, выбранный opts.Chooser
+// из вариантов GetRequestRedeliveryOptions, и возвращает новый интервал доставки.
+//
+// Если destination не задан, доставка переносится по текущему адресу заказа.
+// Если запрос на редактирование не завершился за opts.Timeout,
+// возвращается ошибка ErrEditTimeout
+func Reschedule(ctx context.Context, client DeliveryClient, requestID string, destination Destination, opts RescheduleOptions) (*DeliveryInterval, error) {
+	opts = opts.withDefaults()
+	client = client.WithContext(ctx)
+
+	info, err := client.GetRequestInfo(requestID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	current := info.Request.Destination
+	if destination == (Destination{}) {
+		destination = current
+	}
+
+	actions := []Action{A_UpdateDates}
+	if addressChanged(current, destination) {
+		actions = append(actions, A_UpdateAddress)
+	}
+
+	err = checkActions(requestID, info.Request.AvailableActions, actions...)
+	if err != nil {
+		return nil, err
+	}
+
+	options, err := client.GetRequestRedeliveryOptions(GetRequestRedeliveryOptionsRequest{
+		RequestID:   requestID,
+		Destination: destination,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	interval, err := opts.Chooser(options.Options)
+	if err != nil {
+		return nil, err
+	}
+	destination.IntervalUTC = &interval
+
+	edit, err := client.EditRequestInfo(editRequest(requestID, info.Request, info.Request.LastMilePolicy, destination))
+	if err != nil {
+		return nil, err
+	}
+
+	outcome := edit.Outcome()
+	if err := outcome.Err(); err != nil {
+		return nil, err
+	}
+
+	if outcome.InProgress() {
+		if outcome.EditID == "" {
+			return nil, ErrNoEditID
+		}
+
+		err = waitEdit(ctx, client, outcome.EditID, opts)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	actual, err := client.GetRequestActualInfo(requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &actual.DeliveryInterval, nil
+}
+
+// waitEdit ожидает завершения запроса на редактирование не дольше opts.Timeout
+func waitEdit(ctx context.Context, client DeliveryClient, taskID string, opts RescheduleOptions) error {
+	timeout := time.NewTimer(opts.Timeout)
+	defer timeout.Stop()
+
+	for {
+		resp, err := client.GetEditRequestStatus(taskID)
+		if err != nil {
+			return err
+		}
+
+		switch resp.Status {
+		case ERS_Success:
+			return nil
+		case ERS_Failure:
+			return fmt.Errorf("%w: editing task %v", ErrEditFailed, taskID)
+		case ERS_Pending, ERS_Execution:
+		default:
+			return fmt.Errorf("%w: %q, editing task %v", ErrUnknownEditStatus, resp.Status, taskID)
+		}
+
+		select {
+		case <-time.After(opts.PollInterval):
+		case <-timeout.C:
+			return fmt.Errorf("%w: editing task %v", ErrEditTimeout, taskID)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// addressChanged проверяет, отличается ли место получения без учета интервала доставки
+func addressChanged(current, next Destination) bool {
+	current.IntervalUTC = nil
+	next.IntervalUTC = nil
+	return !reflect.DeepEqual(current, next)
+}
+
+func firstInterval(options []IntervalUTC) (IntervalUTC, error) {
+	if len(options) == 0 {
+		return IntervalUTC{}, ErrNoRedeliveryOptions
+	}
+
+	return options[0], nil
+}
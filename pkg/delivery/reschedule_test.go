@@ -0,0 +1,147 @@
+package delivery_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/delivery"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDelivery_Reschedule(t *testing.T) {
+	now := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	options := []delivery.IntervalUTC{
+		{From: now, To: now.Add(2 * time.Hour)},
+		{From: now.Add(24 * time.Hour), To: now.Add(26 * time.Hour)},
+	}
+	current := delivery.Destination{Type: "custom_location", Address: "Москва, Тверская 1"}
+
+	cases := []struct {
+		Name        string
+		Actions     *delivery.AvailableActions
+		Destination delivery.Destination
+		Edit        delivery.EditRequestInfoResponse
+		Status      delivery.EditingRequestStatus
+		Err         error
+	}{
+		{
+			Name:    "dates",
+			Actions: &delivery.AvailableActions{UpdateDatesAvailable: true},
+			Edit:    delivery.EditRequestInfoResponse{EditID: "edit", ActiveUpdates: []delivery.Update{{Type: "dates"}}},
+		},
+		{
+			Name:        "address",
+			Actions:     &delivery.AvailableActions{UpdateDatesAvailable: true, UpdateAddressAvailable: true},
+			Destination: delivery.Destination{Type: "custom_location", Address: "Москва, Арбат 2"},
+		},
+		{
+			Name:    "dates not available",
+			Actions: &delivery.AvailableActions{UpdateAddressAvailable: true},
+			Err:     delivery.ErrActionNotAvailable,
+		},
+		{
+			Name:        "address not available",
+			Actions:     &delivery.AvailableActions{UpdateDatesAvailable: true},
+			Destination: delivery.Destination{Type: "custom_location", Address: "Москва, Арбат 2"},
+			Err:         delivery.ErrActionNotAvailable,
+		},
+		{
+			Name:    "ignored",
+			Actions: &delivery.AvailableActions{UpdateDatesAvailable: true},
+			Edit:    delivery.EditRequestInfoResponse{IgnoredUpdates: []delivery.Update{{Type: "dates", Reason: "too late"}}},
+			Err:     delivery.ErrEditFailed,
+		},
+		{
+			Name:    "active without edit id",
+			Actions: &delivery.AvailableActions{UpdateDatesAvailable: true},
+			Edit:    delivery.EditRequestInfoResponse{ActiveUpdates: []delivery.Update{{Type: "dates"}}},
+			Err:     delivery.ErrNoEditID,
+		},
+		{
+			Name:    "unknown edit status",
+			Actions: &delivery.AvailableActions{UpdateDatesAvailable: true},
+			Edit:    delivery.EditRequestInfoResponse{EditID: "edit", ActiveUpdates: []delivery.Update{{Type: "dates"}}},
+			Status:  "cancelled",
+			Err:     delivery.ErrUnknownEditStatus,
+		},
+		{
+			Name:    "edit timeout",
+			Actions: &delivery.AvailableActions{UpdateDatesAvailable: true},
+			Edit:    delivery.EditRequestInfoResponse{EditID: "edit", ActiveUpdates: []delivery.Update{{Type: "dates"}}},
+			Status:  delivery.ERS_Pending,
+			Err:     delivery.ErrEditTimeout,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			var edited *delivery.EditRequestInfoRequest
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("/request/info", func(w http.ResponseWriter, r *http.Request) {
+				json.NewEncoder(w).Encode(delivery.GetRequestInfoResponse{
+					RequestID: "req",
+					Request: delivery.RequestInfo{
+						Destination:      current,
+						LastMilePolicy:   delivery.LMP_TimeInterval,
+						Places:           []delivery.Place{{Barcode: "place"}},
+						AvailableActions: c.Actions,
+					},
+				})
+			})
+			mux.HandleFunc("/request/redelivery_options", func(w http.ResponseWriter, r *http.Request) {
+				req := delivery.GetRequestRedeliveryOptionsRequest{}
+				json.NewDecoder(r.Body).Decode(&req)
+				if c.Destination.Address != "" {
+					assert.Equal(t, c.Destination.Address, req.Destination.Address)
+				} else {
+					assert.Equal(t, current.Address, req.Destination.Address)
+				}
+
+				json.NewEncoder(w).Encode(delivery.GetRequestRedeliveryOptionsResponse{Options: options})
+			})
+			mux.HandleFunc("/request/edit", func(w http.ResponseWriter, r *http.Request) {
+				edited = &delivery.EditRequestInfoRequest{}
+				json.NewDecoder(r.Body).Decode(edited)
+				json.NewEncoder(w).Encode(c.Edit)
+			})
+			mux.HandleFunc("/request/edit/status", func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "edit", r.URL.Query().Get("editing_task_id"))
+
+				status := c.Status
+				if status == "" {
+					status = delivery.ERS_Success
+				}
+				json.NewEncoder(w).Encode(delivery.GetEditRequestStatusResponse{Status: status})
+			})
+			mux.HandleFunc("/request/actual_info", func(w http.ResponseWriter, r *http.Request) {
+				json.NewEncoder(w).Encode(delivery.GetRequestActualInfoResponse{
+					DeliveryInterval: delivery.DeliveryInterval{Min: options[1].From, Max: options[1].To},
+				})
+			})
+			d := newTestDelivery(t, mux)
+
+			interval, err := delivery.Reschedule(context.Background(), d, "req", c.Destination, delivery.RescheduleOptions{
+				Chooser: func(options []delivery.IntervalUTC) (delivery.IntervalUTC, error) {
+					return options[1], nil
+				},
+				PollInterval: time.Millisecond,
+				Timeout:      20 * time.Millisecond,
+			})
+			if c.Err != nil {
+				assert.ErrorIs(t, err, c.Err)
+				return
+			}
+
+			if assert.NoError(t, err) && assert.NotNil(t, edited) {
+				assert.True(t, options[1].From.Equal(interval.Min))
+				assert.True(t, options[1].From.Equal(edited.Destination.IntervalUTC.From))
+				assert.Equal(t, string(delivery.LMP_TimeInterval), edited.LastMilePolicy)
+				assert.Equal(t, "place", edited.Places[0].Barcode)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package delivery
+
+import (
+	"errors"
+	"fmt"
+)
+
+// CommitFunc выполняет шаг обработки, следующий за созданием заказа.
+// Если шаг завершился ошибкой, созданный заказ отменяется
+type CommitFunc func(requestID string) error
+
+// TxError описывает ошибку транзакционного создания заказа
+type TxError struct {
+	RequestID       string // Идентификатор созданного и отменяемого заказа
+	Err             error  // Ошибка, возвращенная CommitFunc
+	CompensationErr error  // Ошибка отмены заказа, nil если заказ отменен
+}
+
+func (e *TxError) Error() string {
+	if e.CompensationErr != nil {
+		return fmt.Sprintf("commit request %s: %v; cancel request: %v", e.RequestID, e.Err, e.CompensationErr)
+	}
+
+	return fmt.Sprintf("commit request %s: %v (request cancelled)", e.RequestID, e.Err)
+}
+
+func (e *TxError) Unwrap() []error {
+	if e.CompensationErr != nil {
+		return []error{e.Err, e.CompensationErr}
+	}
+
+	return []error{e.Err}
+}
+
+// ConfirmOfferTx подтверждает оффер и выполняет commit.
+// В случае ошибки commit заказ отменяется с причиной reason
+func ConfirmOfferTx(client DeliveryClient, offerID string, reason Reason, commit CommitFunc) (string, error) {
+	resp, err := client.ConfirmOffer(offerID)
+	if err != nil {
+		return "", err
+	}
+
+	return resp.RequestID, compensate(client, resp.RequestID, reason, commit)
+}
+
+// CreateRequestTx создает заказ и выполняет commit.
+// В случае ошибки commit заказ отменяется с причиной reason
+func CreateRequestTx(client DeliveryClient, req CreateRequestRequest, reason Reason, commit CommitFunc) (string, error) {
+	resp, err := client.CreateRequest(req)
+	if err != nil {
+		return "", err
+	}
+
+	return resp.RequestID, compensate(client, resp.RequestID, reason, commit)
+}
+
+// compensate выполняет commit и отменяет заказ, если commit завершился ошибкой
+func compensate(client DeliveryClient, requestID string, reason Reason, commit CommitFunc) error {
+	err := commit(requestID)
+	if err == nil {
+		return nil
+	}
+
+	if reason == "" {
+		reason = R_Cancel_ShopCanceled
+	}
+
+	txErr := &TxError{RequestID: requestID, Err: err}
+
+	resp, cancelErr := client.CancelRequestWithReason(requestID, reason)
+	switch {
+	case cancelErr != nil:
+		txErr.CompensationErr = cancelErr
+	case resp.Status == "ERROR":
+		txErr.CompensationErr = errors.New(resp.Description)
+	}
+
+	return txErr
+}
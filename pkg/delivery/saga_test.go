@@ -0,0 +1,101 @@
+package delivery_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/delivery"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDelivery_ConfirmOfferTx(t *testing.T) {
+	errCommit := errors.New("commit failed")
+
+	cases := []struct {
+		Name         string
+		Commit       error
+		CancelStatus string
+		Cancelled    bool
+		Compensation bool
+	}{
+		{Name: "commit succeeded"},
+		{Name: "commit failed", Commit: errCommit, CancelStatus: "SUCCESS", Cancelled: true},
+		{Name: "compensation failed", Commit: errCommit, CancelStatus: "ERROR", Cancelled: true, Compensation: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			cancelled := false
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("/offers/confirm", func(w http.ResponseWriter, r *http.Request) {
+				json.NewEncoder(w).Encode(delivery.ConfirmOfferResponse{RequestID: "req"})
+			})
+			mux.HandleFunc("/request/cancel", func(w http.ResponseWriter, r *http.Request) {
+				cancelled = true
+
+				body := map[string]string{}
+				json.NewDecoder(r.Body).Decode(&body)
+				assert.Equal(t, "req", body["request_id"])
+				assert.Equal(t, string(delivery.R_Cancel_DeliveryProblems), body["reason"])
+
+				json.NewEncoder(w).Encode(delivery.CancelRequestResponse{Status: c.CancelStatus, Description: "cancel error"})
+			})
+			d := newTestDelivery(t, mux)
+
+			requestID, err := delivery.ConfirmOfferTx(d, "offer", delivery.R_Cancel_DeliveryProblems, func(requestID string) error {
+				assert.Equal(t, "req", requestID)
+				return c.Commit
+			})
+
+			assert.Equal(t, "req", requestID)
+			assert.Equal(t, c.Cancelled, cancelled)
+			if c.Commit == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			txErr := &delivery.TxError{}
+			if assert.ErrorAs(t, err, &txErr) {
+				assert.ErrorIs(t, err, errCommit)
+				assert.Equal(t, c.Compensation, txErr.CompensationErr != nil)
+			}
+		})
+	}
+}
+
+func TestDelivery_CreateRequestTx(t *testing.T) {
+	errCommit := errors.New("commit failed")
+
+	var cancelled delivery.Reason
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/request/create", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(delivery.CreateRequestResponse{RequestID: "req"})
+	})
+	mux.HandleFunc("/request/cancel", func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]string{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, "req", body["request_id"])
+		cancelled = delivery.Reason(body["reason"])
+
+		json.NewEncoder(w).Encode(delivery.CancelRequestResponse{Status: "SUCCESS"})
+	})
+	d := newTestDelivery(t, mux)
+
+	requestID, err := delivery.CreateRequestTx(d, delivery.CreateRequestRequest{}, "", func(requestID string) error {
+		assert.Equal(t, "req", requestID)
+		return errCommit
+	})
+
+	assert.Equal(t, "req", requestID)
+	assert.Equal(t, delivery.R_Cancel_ShopCanceled, cancelled)
+
+	txErr := &delivery.TxError{}
+	if assert.ErrorAs(t, err, &txErr) {
+		assert.ErrorIs(t, err, errCommit)
+		assert.NoError(t, txErr.CompensationErr)
+	}
+}
@@ -0,0 +1,26 @@
+package delivery
+
+// WithSourceStation возвращает копию клиента, подставляющую склад отправки stationID
+// в запросы расчета стоимости, интервалов, создания офферов и заказов,
+// если склад не указан в самом запросе
+func (d *Delivery) WithSourceStation(stationID string) DeliveryClient {
+	c := *d
+	c.station = stationID
+	return &c
+}
+
+// source подставляет склад отправки клиента в точку отправления без склада.
+// Методы создания офферов и заказов принимают склад в platform_station,
+// методы расчета — в platform_station_id
+func (d *Delivery) source(s Source, nested bool) Source {
+	if d.station == "" || s.PlatformStationID != "" || s.PlatformStation != nil {
+		return s
+	}
+
+	if nested {
+		s.PlatformStation = &PlatformStation{PlatformID: d.station}
+	} else {
+		s.PlatformStationID = d.station
+	}
+	return s
+}
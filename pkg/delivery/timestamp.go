@@ -0,0 +1,205 @@
+package delivery
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// timestampLayouts форматы времени, встречающиеся в ответах API
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	customTimeLayout,
+	"2006-01-02T15:04:05.999999999-0700",
+	"2006-01-02T15:04:05",
+	time.DateTime,
+	time.DateOnly,
+	"15:04Z07:00",
+	"15:04:05Z07:00",
+}
+
+// Timestamp время, принимающее все известные форматы API:
+// RFC 3339, "2006-01-02T15:04:05-0700", дату, время суток со смещением
+// ("10:00+03:00") и UNIX время в секундах.
+// Сериализуется в формате RFC 3339 с долями секунды, время суток — в исходном формате
+type Timestamp struct {
+	time.Time
+}
+
+// ParseTimestamp разбирает время в любом из известных форматов
+func ParseTimestamp(value string) (Timestamp, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return Timestamp{}, nil
+	}
+
+	if unix, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return Timestamp{Time: time.Unix(unix, 0).UTC()}, nil
+	}
+
+	for _, layout := range timestampLayouts {
+		t, err := time.Parse(layout, value)
+		if err == nil {
+			return Timestamp{Time: t}, nil
+		}
+	}
+
+	return Timestamp{}, fmt.Errorf("unknown timestamp format: %q", value)
+}
+
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*t = Timestamp{}
+		return nil
+	}
+
+	var value string
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+	} else {
+		value = string(data)
+	}
+
+	parsed, err := ParseTimestamp(value)
+	if err != nil {
+		return err
+	}
+
+	*t = parsed
+	return nil
+}
+
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(t.String())
+}
+
+// String возвращает время в формате RFC 3339 с долями секунды,
+// время суток — в формате "15:04Z07:00" или "15:04:05Z07:00"
+func (t Timestamp) String() string {
+	if !t.IsClock() {
+		return t.Format(time.RFC3339Nano)
+	}
+
+	if t.Second() == 0 && t.Nanosecond() == 0 {
+		return t.Format("15:04Z07:00")
+	}
+	return t.Format("15:04:05Z07:00")
+}
+
+// IsClock сообщает, что значение содержит только время суток без даты,
+// например "10:00+03:00". Дата такого значения — 1 января нулевого года
+func (t Timestamp) IsClock() bool {
+	year, month, day := t.Date()
+	return !t.IsZero() && year == 0 && month == time.January && day == 1
+}
+
+// In возвращает то же время в часовом поясе loc
+func (t Timestamp) In(loc *time.Location) Timestamp {
+	return Timestamp{Time: t.Time.In(loc)}
+}
+
+// TimeZone возвращает часовой пояс со смещением hours часов от UTC
+func TimeZone(hours int64) *time.Location {
+	return time.FixedZone(fmt.Sprintf("UTC%+d", hours), int(hours)*3600)
+}
+
+// Location возвращает часовой пояс расписания
+func (s Schedule) Location() *time.Location {
+	return TimeZone(s.TimeZone)
+}
+
+// Location возвращает часовой пояс точки по ее расписанию
+func (p Point) Location() *time.Location {
+	return p.Schedule.Location()
+}
+
+// Location возвращает часовой пояс интервала доставки получателю
+func (i ActualDeliveryInterval) Location() *time.Location {
+	return i.From.Location()
+}
+
+// On возвращает границы интервала в день date в часовом поясе интервала
+func (i ActualDeliveryInterval) On(date time.Time) (from, to time.Time) {
+	loc := i.Location()
+	day := func(clock Timestamp) time.Time {
+		return time.Date(date.Year(), date.Month(), date.Day(), clock.Hour(), clock.Minute(), clock.Second(), 0, loc)
+	}
+
+	return day(i.From), day(i.To)
+}
+
+// UnmarshalJSON принимает границы интервала в любом формате Timestamp
+func (o *Offer) UnmarshalJSON(data []byte) error {
+	type offer Offer
+	var raw struct {
+		offer
+		From Timestamp `json:"from"`
+		To   Timestamp `json:"to"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*o = Offer(raw.offer)
+	o.From = raw.From.Time
+	o.To = raw.To.Time
+	return nil
+}
+
+// UnmarshalJSON разбирает оффер с временем в любом формате Timestamp
+func (o *OfferItem) UnmarshalJSON(data []byte) error {
+	type offerItem OfferItem
+	var raw struct {
+		offerItem
+		ExpiresAt Timestamp `json:"expires_at"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*o = OfferItem(raw.offerItem)
+	o.ExpiresAt = raw.ExpiresAt.Time
+	return nil
+}
+
+// UnmarshalJSON разбирает интервал доставки с временем в любом формате Timestamp
+func (i *DeliveryInterval) UnmarshalJSON(data []byte) error {
+	type deliveryInterval DeliveryInterval
+	var raw struct {
+		deliveryInterval
+		Min Timestamp `json:"min"`
+		Max Timestamp `json:"max"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*i = DeliveryInterval(raw.deliveryInterval)
+	i.Min = raw.Min.Time
+	i.Max = raw.Max.Time
+	return nil
+}
+
+// UnmarshalJSON разбирает интервал забора с временем в любом формате Timestamp
+func (i *PickupInterval) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Min Timestamp `json:"min"`
+		Max Timestamp `json:"max"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	i.Min = raw.Min.Time
+	i.Max = raw.Max.Time
+	return nil
+}
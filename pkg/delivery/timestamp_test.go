@@ -0,0 +1,102 @@
+package delivery_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/delivery"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimestamp_UnmarshalJSON(t *testing.T) {
+	msk := time.FixedZone("", 3*3600)
+
+	cases := []struct {
+		Name     string
+		JSON     string
+		Expected time.Time
+	}{
+		{Name: "rfc3339", JSON: `"2025-03-01T10:00:00Z"`, Expected: time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)},
+		{Name: "rfc3339 nano", JSON: `"2025-03-01T13:00:00.123+03:00"`, Expected: time.Date(2025, 3, 1, 13, 0, 0, 123000000, msk)},
+		{Name: "custom layout", JSON: `"2025-03-01T13:00:00+0300"`, Expected: time.Date(2025, 3, 1, 13, 0, 0, 0, msk)},
+		{Name: "date", JSON: `"2025-03-01"`, Expected: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{Name: "clock", JSON: `"10:00+03:00"`, Expected: time.Date(0, 1, 1, 10, 0, 0, 0, msk)},
+		{Name: "unix", JSON: `1740823200`, Expected: time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)},
+		{Name: "unix string", JSON: `"1740823200"`, Expected: time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)},
+		{Name: "empty", JSON: `""`},
+		{Name: "null", JSON: `null`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			ts := delivery.Timestamp{}
+			if assert.NoError(t, json.Unmarshal([]byte(c.JSON), &ts)) {
+				assert.True(t, c.Expected.Equal(ts.Time), "expected %v, got %v", c.Expected, ts.Time)
+			}
+		})
+	}
+
+	ts := delivery.Timestamp{}
+	assert.Error(t, json.Unmarshal([]byte(`"yesterday"`), &ts))
+}
+
+func TestTimestamp_Models(t *testing.T) {
+	history := delivery.GetRequestHistoryResponse{}
+	err := json.Unmarshal([]byte(`{"state_history": [{"status": "CREATED", "timestamp_utc": "2025-03-01T10:00:00+0000"}]}`), &history)
+	if assert.NoError(t, err) {
+		assert.Equal(t, 2025, history.StateHistory[0].TimestampUTC.Year())
+	}
+
+	offers := delivery.DeliveryIntervalsResponse{}
+	err = json.Unmarshal([]byte(`{"offers": [{"from": 1740823200, "to": "2025-03-01T12:00:00Z", "delivered_by_post": true}]}`), &offers)
+	if assert.NoError(t, err) {
+		assert.Equal(t, 10, offers.Offers[0].From.Hour())
+		assert.Equal(t, 12, offers.Offers[0].To.Hour())
+		assert.True(t, offers.Offers[0].DeliveredByPost)
+	}
+
+	interval := delivery.IntervalUTC{}
+	err = json.Unmarshal([]byte(`{"from": "2025-03-01T10:00:00Z", "to": 1740830400}`), &interval)
+	if assert.NoError(t, err) {
+		assert.Equal(t, 10, interval.From.Hour())
+		assert.Equal(t, 12, interval.To.Hour())
+	}
+}
+
+func TestTimestamp_Location(t *testing.T) {
+	actual := delivery.ActualDeliveryInterval{}
+	err := json.Unmarshal([]byte(`{"from": "10:00+03:00", "to": "11:30+03:00"}`), &actual)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	from, to := actual.On(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC))
+	assert.True(t, time.Date(2025, 3, 1, 7, 0, 0, 0, time.UTC).Equal(from))
+	assert.True(t, time.Date(2025, 3, 1, 8, 30, 0, 0, time.UTC).Equal(to))
+
+	point := delivery.Point{Schedule: delivery.Schedule{TimeZone: 5}}
+	ts, err := delivery.ParseTimestamp("2025-03-01T10:00:00Z")
+	if assert.NoError(t, err) {
+		assert.Equal(t, 15, ts.In(point.Location()).Hour())
+	}
+
+	data, err := json.Marshal(ts)
+	assert.NoError(t, err)
+	assert.Equal(t, `"2025-03-01T10:00:00Z"`, string(data))
+}
+
+func TestTimestamp_MarshalJSON(t *testing.T) {
+	for _, value := range []string{`"10:00+03:00"`, `"10:00:30Z"`, `"2025-03-01T10:00:00+03:00"`, `"2025-03-01T13:00:00.123+03:00"`, `null`} {
+		t.Run(value, func(t *testing.T) {
+			ts := delivery.Timestamp{}
+			if !assert.NoError(t, json.Unmarshal([]byte(value), &ts)) {
+				return
+			}
+
+			data, err := json.Marshal(ts)
+			assert.NoError(t, err)
+			assert.Equal(t, value, string(data))
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package delivery
+
+import (
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/ReanSn0w/go-yandex-delivery/pkg/delivery"
+
+// trace начинает span операции и возвращает копию клиента,
+// запросы которой выполняются в контексте span
+func (d *Delivery) trace(operation string, attrs ...attribute.KeyValue) (*Delivery, trace.Span) {
+	ctx, span := otel.Tracer(tracerName).Start(d.ctx, "delivery."+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...))
+	return d.withContext(ctx), span
+}
+
+// endSpan завершает span с учетом ошибки операции
+func endSpan(span trace.Span, err error) error {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+	return err
+}
@@ -0,0 +1,52 @@
+package delivery_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/delivery"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestDelivery_Trace(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/offers/confirm", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(delivery.ConfirmOfferResponse{RequestID: "req"})
+	})
+	mux.HandleFunc("/pricing-calculator", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"code":"bad_request"}`))
+	})
+	d := newTestDelivery(t, mux)
+
+	_, err := d.ConfirmOffer("offer")
+	assert.NoError(t, err)
+
+	_, err = d.GetPredictedPrice(true, delivery.PredictPriceRequest{})
+	assert.Error(t, err)
+
+	spans := recorder.Ended()
+	if !assert.Len(t, spans, 2) {
+		return
+	}
+
+	assert.Equal(t, "delivery.ConfirmOffer", spans[0].Name())
+	assert.Contains(t, spans[0].Attributes(), attribute.String("offer_id", "offer"))
+	assert.Contains(t, spans[0].Attributes(), attribute.String("request_id", "req"))
+	assert.Equal(t, codes.Unset, spans[0].Status().Code)
+
+	assert.Equal(t, "delivery.GetPredictedPrice", spans[1].Name())
+	assert.Contains(t, spans[1].Attributes(), attribute.Bool("is_oversized", true))
+	assert.Equal(t, codes.Error, spans[1].Status().Code)
+}
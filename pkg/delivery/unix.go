@@ -0,0 +1,92 @@
+package delivery
+
+import "encoding/json"
+
+// WithUnixTime возвращает копию клиента, передающую время в запросах
+// /offers/info, /offers/create и /request/create в формате UNIX (send_unix=true).
+// Интервалы в теле этих запросов сериализуются в том же формате.
+// По умолчанию время передается строкой в формате "2006-01-02T15:04:05-0700" (send_unix=false).
+// Остальные методы, например EditRequestInfo и GetRequestRedeliveryOptions,
+// не принимают send_unix и всегда получают время строкой
+func (d *Delivery) WithUnixTime(enabled bool) DeliveryClient {
+	c := *d
+	c.unix = enabled
+	return &c
+}
+
+// wireInterval интервал в теле запроса в формате, выбранном клиентом
+type wireInterval struct {
+	interval IntervalUTC
+	unix     bool
+}
+
+func (i wireInterval) MarshalJSON() ([]byte, error) {
+	if !i.unix {
+		return json.Marshal(i.interval)
+	}
+
+	return json.Marshal(map[string]int64{
+		"from": i.interval.From.Unix(),
+		"to":   i.interval.To.Unix(),
+	})
+}
+
+// wireSource точка отправления в теле запроса.
+// Поле IntervalUTC заменяет одноименное поле Source при сериализации
+type wireSource struct {
+	Source
+	IntervalUTC *wireInterval `json:"interval_utc,omitempty"`
+}
+
+// wireDestination точка получения в теле запроса.
+// Поле IntervalUTC заменяет одноименное поле Destination при сериализации
+type wireDestination struct {
+	Destination
+	IntervalUTC *wireInterval `json:"interval_utc,omitempty"`
+}
+
+// wireRequest заказ в теле запросов /offers/create и /request/create
+type wireRequest struct {
+	RequestInfo
+	Source      wireSource      `json:"source"`
+	Destination wireDestination `json:"destination"`
+}
+
+// wireIntervalsRequest тело запроса /offers/info
+type wireIntervalsRequest struct {
+	DeliveryIntervalsRequest
+	Source      wireSource      `json:"source"`
+	Destination wireDestination `json:"destination"`
+}
+
+func (i *IntervalUTC) wire(unix bool) *wireInterval {
+	if i == nil {
+		return nil
+	}
+
+	return &wireInterval{interval: *i, unix: unix}
+}
+
+func (s Source) wire(unix bool) wireSource {
+	return wireSource{Source: s, IntervalUTC: s.IntervalUTC.wire(unix)}
+}
+
+func (d Destination) wire(unix bool) wireDestination {
+	return wireDestination{Destination: d, IntervalUTC: d.IntervalUTC.wire(unix)}
+}
+
+func (r RequestInfo) wire(unix bool) wireRequest {
+	return wireRequest{
+		RequestInfo: r,
+		Source:      r.Source.wire(unix),
+		Destination: r.Destination.wire(unix),
+	}
+}
+
+func (r DeliveryIntervalsRequest) wire(unix bool) wireIntervalsRequest {
+	return wireIntervalsRequest{
+		DeliveryIntervalsRequest: r,
+		Source:                   r.Source.wire(unix),
+		Destination:              r.Destination.wire(unix),
+	}
+}
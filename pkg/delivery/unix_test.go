@@ -0,0 +1,143 @@
+package delivery_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/delivery"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDelivery_WithUnixTime(t *testing.T) {
+	from := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+	to := from.Add(4 * time.Hour)
+	expires := from.Add(-time.Hour)
+
+	cases := []struct {
+		Name   string
+		Unix   bool
+		Format func(time.Time) any
+	}{
+		{
+			Name:   "string",
+			Format: func(t time.Time) any { return t.Format("2006-01-02T15:04:05-0700") },
+		},
+		{
+			Name:   "unix",
+			Unix:   true,
+			Format: func(t time.Time) any { return t.Unix() },
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			// checkBody проверяет формат интервалов в теле запроса
+			// и возможность разобрать тело обратно в модель
+			checkBody := func(t *testing.T, r *http.Request) {
+				assert.Equal(t, fmt.Sprint(c.Unix), r.URL.Query().Get("send_unix"))
+
+				var raw struct {
+					Source struct {
+						IntervalUTC json.RawMessage `json:"interval_utc"`
+					} `json:"source"`
+					Destination struct {
+						IntervalUTC json.RawMessage `json:"interval_utc"`
+					} `json:"destination"`
+				}
+				body := json.RawMessage{}
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.NoError(t, json.Unmarshal(body, &raw))
+
+				want, _ := json.Marshal(map[string]any{"from": c.Format(from), "to": c.Format(to)})
+				assert.JSONEq(t, string(want), string(raw.Source.IntervalUTC))
+				assert.JSONEq(t, string(want), string(raw.Destination.IntervalUTC))
+
+				req := delivery.RequestInfo{}
+				assert.NoError(t, json.Unmarshal(body, &req))
+				assert.True(t, req.Source.IntervalUTC.From.Equal(from))
+				assert.True(t, req.Destination.IntervalUTC.To.Equal(to))
+			}
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("/offers/info", func(w http.ResponseWriter, r *http.Request) {
+				checkBody(t, r)
+				json.NewEncoder(w).Encode(map[string]any{
+					"offers": []map[string]any{{"from": c.Format(from), "to": c.Format(to)}},
+				})
+			})
+			mux.HandleFunc("/offers/create", func(w http.ResponseWriter, r *http.Request) {
+				checkBody(t, r)
+				json.NewEncoder(w).Encode(map[string]any{
+					"offers": []map[string]any{{
+						"offer_id":   "offer",
+						"expires_at": c.Format(expires),
+						"offer_details": map[string]any{
+							"delivery_interval": map[string]any{"min": c.Format(from), "max": c.Format(to), "policy": "time_interval"},
+							"pickup_interval":   map[string]any{"min": c.Format(expires), "max": c.Format(from)},
+						},
+					}},
+				})
+			})
+			mux.HandleFunc("/request/create", func(w http.ResponseWriter, r *http.Request) {
+				checkBody(t, r)
+				json.NewEncoder(w).Encode(delivery.CreateRequestResponse{RequestID: "req"})
+			})
+			mux.HandleFunc("/request/edit", func(w http.ResponseWriter, r *http.Request) {
+				// Метод не принимает send_unix, время всегда передается строкой
+				assert.False(t, r.URL.Query().Has("send_unix"))
+
+				var raw struct {
+					Destination struct {
+						IntervalUTC json.RawMessage `json:"interval_utc"`
+					} `json:"destination"`
+				}
+				json.NewDecoder(r.Body).Decode(&raw)
+				assert.JSONEq(t, `{"from":"2025-03-10T09:00:00+0000","to":"2025-03-10T13:00:00+0000"}`, string(raw.Destination.IntervalUTC))
+				json.NewEncoder(w).Encode(delivery.EditRequestInfoResponse{})
+			})
+			d := newTestDelivery(t, mux).WithUnixTime(c.Unix)
+
+			interval := &delivery.IntervalUTC{From: from, To: to}
+			source := delivery.Source{PlatformStationID: "station", IntervalUTC: interval}
+			destination := delivery.Destination{Type: "custom_location", IntervalUTC: interval}
+			info := delivery.RequestInfo{Source: source, Destination: destination}
+
+			intervals, err := d.GetDeliveryIntervals(false, delivery.LMP_TimeInterval, delivery.DeliveryIntervalsRequest{
+				Source:      source,
+				Destination: destination,
+			})
+			if assert.NoError(t, err) && assert.Len(t, intervals.Offers, 1) {
+				assert.True(t, intervals.Offers[0].From.Equal(from))
+				assert.True(t, intervals.Offers[0].To.Equal(to))
+			}
+
+			offers, err := d.CreateOffer(delivery.CreateOfferRequest(info))
+			if assert.NoError(t, err) && assert.Len(t, offers.Offers, 1) {
+				offer := offers.Offers[0]
+				assert.Equal(t, "offer", offer.OfferID)
+				assert.True(t, offer.ExpiresAt.Equal(expires))
+				assert.True(t, offer.OfferDetails.DeliveryInterval.Min.Equal(from))
+				assert.True(t, offer.OfferDetails.DeliveryInterval.Max.Equal(to))
+				assert.Equal(t, "time_interval", offer.OfferDetails.DeliveryInterval.Policy)
+				assert.True(t, offer.OfferDetails.PickupInterval.Min.Equal(expires))
+				assert.True(t, offer.OfferDetails.PickupInterval.Max.Equal(from))
+			}
+
+			resp, err := d.CreateRequest(delivery.CreateRequestRequest(info))
+			if assert.NoError(t, err) {
+				assert.Equal(t, "req", resp.RequestID)
+			}
+
+			_, err = d.EditRequestInfo(delivery.EditRequestInfoRequest{RequestID: "req", Destination: destination})
+			assert.NoError(t, err)
+
+			// Интервал, переданный в запрос, не изменяется
+			data, err := json.Marshal(interval)
+			assert.NoError(t, err)
+			assert.JSONEq(t, `{"from":"2025-03-10T09:00:00+0000","to":"2025-03-10T13:00:00+0000"}`, string(data))
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/utils"
+	"github.com/ReanSn0w/gokit/pkg/web"
+	"github.com/go-pkgz/lgr"
+)
+
+var (
+	// DefaultRedactFields поля JSON, значения которых скрываются по умолчанию
+	DefaultRedactFields = []string{
+		"phone", "email", "first_name", "last_name", "partonymic", "full_address",
+	}
+
+	// DefaultRequestIDHeaders заголовки ответа с идентификатором запроса Яндекса
+	DefaultRequestIDHeaders = []string{"X-YaRequestId", "X-Request-Id"}
+)
+
+// Options настройки журналирования запросов
+type Options struct {
+	Body             bool     // Журналировать тела запросов и ответов
+	RedactFields     []string // Поля JSON, значения которых скрываются. По умолчанию DefaultRedactFields
+	RequestIDHeaders []string // Заголовки с идентификатором запроса. По умолчанию DefaultRequestIDHeaders
+}
+
+// New создает клиент, журналирующий запросы в lgr.L
+func New(log lgr.L, client web.HTTPClient, opts Options) *Client {
+	return newClient(client, opts, func(e *entry) {
+		level := "INFO"
+		if e.err != nil || e.status >= http.StatusBadRequest {
+			level = "WARN"
+		}
+
+		log.Logf("[%s] %s %s status=%d latency=%v request_id=%s%s", level, e.method, e.path, e.status, e.latency, e.requestID, e.errorSuffix())
+		if opts.Body {
+			log.Logf("[DEBUG] %s %s request=%s response=%s", e.method, e.path, e.reqBody, e.respBody)
+		}
+	})
+}
+
+// NewSlog создает клиент, журналирующий запросы в slog.Logger.
+// Записи передаются обработчику с контекстом запроса
+func NewSlog(log *slog.Logger, client web.HTTPClient, opts Options) *Client {
+	return newClient(client, opts, func(e *entry) {
+		level := slog.LevelInfo
+		if e.err != nil || e.status >= http.StatusBadRequest {
+			level = slog.LevelWarn
+		}
+
+		attrs := []slog.Attr{
+			slog.String("method", e.method),
+			slog.String("path", e.path),
+			slog.Int("status", e.status),
+			slog.Duration("latency", e.latency),
+			slog.String("request_id", e.requestID),
+		}
+		if e.err != nil {
+			attrs = append(attrs, slog.String("error", e.err.Error()))
+		}
+		if opts.Body {
+			attrs = append(attrs, slog.String("request", e.reqBody), slog.String("response", e.respBody))
+		}
+
+		log.LogAttrs(e.ctx, level, "yandex delivery request", attrs...)
+	})
+}
+
+// Middleware возвращает api.Middleware, журналирующую запросы в lgr.L
+func Middleware(log lgr.L, opts Options) func(web.HTTPClient) web.HTTPClient {
+	return func(next web.HTTPClient) web.HTTPClient {
+		return New(log, next, opts)
+	}
+}
+
+// SlogMiddleware возвращает api.Middleware, журналирующую запросы в slog.Logger
+func SlogMiddleware(log *slog.Logger, opts Options) func(web.HTTPClient) web.HTTPClient {
+	return func(next web.HTTPClient) web.HTTPClient {
+		return NewSlog(log, next, opts)
+	}
+}
+
+func newClient(client web.HTTPClient, opts Options, write func(*entry)) *Client {
+	fields := opts.RedactFields
+	if fields == nil {
+		fields = DefaultRedactFields
+	}
+
+	headers := opts.RequestIDHeaders
+	if headers == nil {
+		headers = DefaultRequestIDHeaders
+	}
+
+	return &Client{
+		client:   client,
+		body:     opts.Body,
+		redactor: newRedactor(fields),
+		headers:  headers,
+		write:    write,
+	}
+}
+
+// Client журналирует запросы, выполняемые через вложенный клиент
+type Client struct {
+	client   web.HTTPClient
+	body     bool
+	redactor *redactor
+	headers  []string
+	write    func(*entry)
+}
+
+func (c *Client) Do(req *http.Request) (*http.Response, error) {
+	e := &entry{ctx: req.Context(), method: req.Method, path: req.URL.Path}
+
+	if c.body && req.Body != nil {
+		data, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		req.Body = io.NopCloser(bytes.NewReader(data))
+		e.reqBody = c.redactor.redact(data)
+	}
+
+	start := time.Now()
+	resp, err := c.client.Do(req)
+	e.latency = time.Since(start)
+	e.err = err
+
+	if resp != nil {
+		e.status = resp.StatusCode
+		for _, header := range c.headers {
+			if id := resp.Header.Get(header); id != "" {
+				e.requestID = id
+				break
+			}
+		}
+
+		if c.body {
+			data, readErr := utils.ReadBody(resp)
+			if readErr != nil {
+				e.err = readErr
+			}
+			e.respBody = c.redactor.redact(data)
+		}
+	}
+
+	c.write(e)
+	return resp, err
+}
+
+// entry запись журнала о выполненном запросе
+type entry struct {
+	ctx       context.Context
+	method    string
+	path      string
+	status    int
+	latency   time.Duration
+	requestID string
+	reqBody   string
+	respBody  string
+	err       error
+}
+
+func (e *entry) errorSuffix() string {
+	if e.err == nil {
+		return ""
+	}
+
+	return fmt.Sprintf(" error=%v", e.err)
+}
@@ -0,0 +1,104 @@
+package logging_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/logging"
+	"github.com/ReanSn0w/gokit/pkg/web"
+	"github.com/go-pkgz/lgr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Do(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Contains(t, string(body), "+79261234567", "request body must reach the server unchanged")
+
+		w.Header().Set("X-YaRequestId", "ya-123")
+		w.Write([]byte(`{"recipient_info":{"first_name":"Иван","phone":"+79261234567"},"message":"Bearer secret-token","count":2}`))
+	}))
+	defer server.Close()
+
+	body := `{"recipient_info":{"first_name":"Иван","phone":"+79261234567","email":"ivan@example.com"},"destination":{"custom_location":{"details":{"full_address":"Москва"}}},"items":[{"name":"Чехол"}]}`
+
+	cases := []struct {
+		Name   string
+		Client func(out io.Writer) web.HTTPClient
+	}{
+		{
+			Name: "lgr",
+			Client: func(out io.Writer) web.HTTPClient {
+				return logging.New(lgr.New(lgr.Debug, lgr.Out(out)), server.Client(), logging.Options{Body: true})
+			},
+		},
+		{
+			Name: "slog",
+			Client: func(out io.Writer) web.HTTPClient {
+				return logging.NewSlog(slog.New(slog.NewTextHandler(out, nil)), server.Client(), logging.Options{Body: true})
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			out := new(bytes.Buffer)
+
+			req, _ := http.NewRequest(http.MethodPost, server.URL+"/request/create", strings.NewReader(body))
+			req.Header.Set("Authorization", "Bearer secret-token")
+
+			resp, err := c.Client(out).Do(req)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			respBody, _ := io.ReadAll(resp.Body)
+			assert.Contains(t, string(respBody), "+79261234567", "response body must reach the caller unchanged")
+
+			log := out.String()
+			assert.Contains(t, log, "/request/create")
+			assert.Contains(t, log, "200")
+			assert.Contains(t, log, "ya-123")
+			assert.Contains(t, log, "Чехол")
+			for _, secret := range []string{"+79261234567", "ivan@example.com", "Иван", "Москва", "secret-token"} {
+				assert.NotContains(t, log, secret)
+			}
+		})
+	}
+}
+
+type ctxKey struct{}
+
+// contextHandler сохраняет значение ctxKey из контекста записи
+type contextHandler struct {
+	slog.Handler
+	value *any
+}
+
+func (h contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	*h.value = ctx.Value(ctxKey{})
+	return h.Handler.Handle(ctx, r)
+}
+
+func TestNewSlog_Context(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	var value any
+	log := slog.New(contextHandler{Handler: slog.NewTextHandler(io.Discard, nil), value: &value})
+
+	ctx := context.WithValue(context.Background(), ctxKey{}, "trace")
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/request/info", nil)
+
+	_, err := logging.NewSlog(log, server.Client(), logging.Options{}).Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, "trace", value)
+}
@@ -0,0 +1,68 @@
+package logging
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const redacted = "***"
+
+var bearerPattern = regexp.MustCompile(`(?i)bearer\s+[^\s"]+`)
+
+// redactor скрывает персональные данные в JSON телах
+type redactor struct {
+	fields map[string]struct{}
+}
+
+func newRedactor(fields []string) *redactor {
+	r := &redactor{fields: make(map[string]struct{}, len(fields))}
+	for _, field := range fields {
+		r.fields[strings.ToLower(field)] = struct{}{}
+	}
+	return r
+}
+
+// redact возвращает тело с замаскированными значениями.
+// Тела, не являющиеся JSON, не журналируются
+func (r *redactor) redact(data []byte) string {
+	if len(data) == 0 {
+		return ""
+	}
+
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return fmt.Sprintf("<non-json body: %d bytes>", len(data))
+	}
+
+	out, err := json.Marshal(r.walk(v))
+	if err != nil {
+		return fmt.Sprintf("<body: %d bytes>", len(data))
+	}
+
+	return string(out)
+}
+
+func (r *redactor) walk(v any) any {
+	switch val := v.(type) {
+	case map[string]any:
+		for key, item := range val {
+			if _, ok := r.fields[strings.ToLower(key)]; ok {
+				val[key] = redacted
+				continue
+			}
+			val[key] = r.walk(item)
+		}
+		return val
+	case []any:
+		for i, item := range val {
+			val[i] = r.walk(item)
+		}
+		return val
+	case string:
+		return bearerPattern.ReplaceAllString(val, "Bearer "+redacted)
+	default:
+		return v
+	}
+}
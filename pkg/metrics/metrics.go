@@ -0,0 +1,134 @@
+package metrics
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/utils"
+	"github.com/ReanSn0w/gokit/pkg/web"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// New создает коллектор метрик запросов к API.
+// Коллектор необходимо зарегистрировать в prometheus.Registerer
+// и подключить к клиенту через api.API.WithMetrics
+func New(namespace string) *Collector {
+	return &Collector{
+		calls: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "yandex_delivery",
+			Name:      "requests_total",
+			Help:      "Total number of Yandex Delivery API requests.",
+		}, []string{"endpoint"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "yandex_delivery",
+			Name:      "errors_total",
+			Help:      "Total number of failed Yandex Delivery API requests.",
+		}, []string{"endpoint", "status", "code"}),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "yandex_delivery",
+			Name:      "request_duration_seconds",
+			Help:      "Yandex Delivery API request latency.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"endpoint"}),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "yandex_delivery",
+			Name:      "retries_total",
+			Help:      "Total number of retried Yandex Delivery API requests.",
+		}, []string{"endpoint"}),
+		waits: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "yandex_delivery",
+			Name:      "rate_limit_wait_seconds",
+			Help:      "Time spent waiting for the rate limiter.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"endpoint"}),
+	}
+}
+
+// Collector метрики запросов к API доставки
+type Collector struct {
+	calls   *prometheus.CounterVec
+	errors  *prometheus.CounterVec
+	latency *prometheus.HistogramVec
+	retries *prometheus.CounterVec
+	waits   *prometheus.HistogramVec
+}
+
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	c.calls.Describe(ch)
+	c.errors.Describe(ch)
+	c.latency.Describe(ch)
+	c.retries.Describe(ch)
+	c.waits.Describe(ch)
+}
+
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.calls.Collect(ch)
+	c.errors.Collect(ch)
+	c.latency.Collect(ch)
+	c.retries.Collect(ch)
+	c.waits.Collect(ch)
+}
+
+// Client оборачивает HTTP клиент для сбора метрик запросов.
+// Метод может использоваться как api.Middleware
+func (c *Collector) Client(client web.HTTPClient) web.HTTPClient {
+	return &metricsClient{client: client, collector: c}
+}
+
+// ObserveRetry учитывает повторную попытку выполнения запроса
+func (c *Collector) ObserveRetry(endpoint string) {
+	c.retries.WithLabelValues(endpoint).Inc()
+}
+
+// ObserveRateLimitWait учитывает время ожидания ограничителя частоты запросов
+func (c *Collector) ObserveRateLimitWait(endpoint string, wait time.Duration) {
+	c.waits.WithLabelValues(endpoint).Observe(wait.Seconds())
+}
+
+type metricsClient struct {
+	client    web.HTTPClient
+	collector *Collector
+}
+
+func (m *metricsClient) Do(req *http.Request) (*http.Response, error) {
+	endpoint := utils.Endpoint(req.Context())
+	if endpoint == "" {
+		endpoint = req.URL.Path
+	}
+
+	start := time.Now()
+	resp, err := m.client.Do(req)
+	m.collector.latency.WithLabelValues(endpoint).Observe(time.Since(start).Seconds())
+	m.collector.calls.WithLabelValues(endpoint).Inc()
+
+	switch {
+	case err != nil:
+		m.collector.errors.WithLabelValues(endpoint, "", "network").Inc()
+	case resp.StatusCode >= http.StatusBadRequest:
+		m.collector.errors.WithLabelValues(endpoint, strconv.Itoa(resp.StatusCode), errorCode(resp)).Inc()
+	}
+
+	return resp, err
+}
+
+// errorCode возвращает код ошибки из тела ответа API,
+// тело ответа при этом остается доступным для чтения
+func errorCode(resp *http.Response) string {
+	data, err := utils.ReadBody(resp)
+	if err != nil {
+		return ""
+	}
+
+	body := struct {
+		Code string `json:"code"`
+	}{}
+	json.Unmarshal(data, &body)
+	return body.Code
+}
@@ -0,0 +1,65 @@
+package metrics_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/api"
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/metrics"
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/utils"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+type testLimiter struct{}
+
+func (testLimiter) Wait(ctx context.Context) error { return nil }
+
+func TestCollector(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/offers/create" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"code":"no_delivery_options","message":"no options"}`))
+			return
+		}
+
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	collector := metrics.New("test")
+	a := api.New(utils.Development, server.Client(), "token").
+		WithLimiter(testLimiter{}).
+		WithMetrics(collector)
+
+	res := map[string]any{}
+	assert.NoError(t, a.Request(server.URL, "/pickup-points/list").Do(&res))
+	assert.NoError(t, a.Request(server.URL, "/pickup-points/list").Do(&res))
+
+	err := a.Request(server.URL, "/offers/create").Do(&res)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "no_delivery_options", "error body must stay readable")
+	}
+
+	collector.ObserveRetry("/offers/create")
+	collector.ObserveRateLimitWait("/offers/create", time.Second)
+
+	assert.Equal(t, 2, testutil.CollectAndCount(collector, "test_yandex_delivery_request_duration_seconds"))
+	assert.NoError(t, testutil.CollectAndCompare(collector, strings.NewReader(`
+# HELP test_yandex_delivery_requests_total Total number of Yandex Delivery API requests.
+# TYPE test_yandex_delivery_requests_total counter
+test_yandex_delivery_requests_total{endpoint="/offers/create"} 1
+test_yandex_delivery_requests_total{endpoint="/pickup-points/list"} 2
+# HELP test_yandex_delivery_errors_total Total number of failed Yandex Delivery API requests.
+# TYPE test_yandex_delivery_errors_total counter
+test_yandex_delivery_errors_total{code="no_delivery_options",endpoint="/offers/create",status="400"} 1
+# HELP test_yandex_delivery_retries_total Total number of retried Yandex Delivery API requests.
+# TYPE test_yandex_delivery_retries_total counter
+test_yandex_delivery_retries_total{endpoint="/offers/create"} 1
+`), "test_yandex_delivery_requests_total", "test_yandex_delivery_errors_total", "test_yandex_delivery_retries_total"))
+	assert.Equal(t, 2, testutil.CollectAndCount(collector, "test_yandex_delivery_rate_limit_wait_seconds"))
+}
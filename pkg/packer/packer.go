@@ -0,0 +1,297 @@
+// Package packer распределяет товары заказа по грузоместам
+package packer
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/delivery"
+)
+
+var (
+	ErrNoBoxes      = errors.New("no boxes")
+	ErrNoDimensions = errors.New("item has no physical dimensions")
+	ErrItemTooLarge = errors.New("item does not fit into any box")
+	ErrNoCount      = errors.New("item count must be positive")
+)
+
+// Box тип коробки, в которую упаковываются товары
+type Box struct {
+	Name      string  // Название коробки
+	Dx        float64 // Внутренняя длина, сантиметры
+	Dy        float64 // Внутренняя высота, сантиметры
+	Dz        float64 // Внутренняя ширина, сантиметры
+	Weight    float64 // Вес пустой коробки, граммы
+	MaxWeight float64 // Максимальный вес брутто, граммы. 0 - без ограничения
+}
+
+func (b Box) volume() float64 {
+	return b.Dx * b.Dy * b.Dz
+}
+
+// Options настройки упаковки
+type Options struct {
+	// Доступные коробки
+	Boxes []Box
+
+	// Штрихкод грузоместа по его порядковому номеру начиная с 0.
+	// По умолчанию: place-1, place-2, ...
+	Barcode func(i int) string
+}
+
+// Result результат упаковки
+type Result struct {
+	// Товары с заполненным PlaceBarcode.
+	// Товар, единицы которого попали в разные грузоместа,
+	// разделяется на несколько позиций с соответствующим Count
+	Items []delivery.Item
+
+	// Грузоместа в порядке создания
+	Places []Place
+}
+
+// Place упакованное грузоместо
+type Place struct {
+	Barcode string                // Штрихкод грузоместа
+	Box     Box                   // Коробка грузоместа
+	Weight  float64               // Вес брутто, граммы
+	Items   []delivery.PlacesItem // Товары грузоместа, в качестве штрихкода используется артикул
+}
+
+// DeliveryPlaces возвращает грузоместа для создания оффера или заказа
+func (r *Result) DeliveryPlaces() []delivery.Place {
+	places := make([]delivery.Place, 0, len(r.Places))
+	for _, place := range r.Places {
+		places = append(places, delivery.Place{
+			Barcode: place.Barcode,
+			PhysicalDims: delivery.PhysicalDims{
+				WeightGross: place.Weight,
+				Dx:          place.Box.Dx,
+				Dy:          place.Box.Dy,
+				Dz:          place.Box.Dz,
+			},
+		})
+	}
+
+	return places
+}
+
+// EditRequests возвращает запросы EditRequestPlaces для каждого грузоместа заказа
+func (r *Result) EditRequests(requestID string) []delivery.EditRequestPlacesRequest {
+	reqs := make([]delivery.EditRequestPlacesRequest, 0, len(r.Places))
+	for _, place := range r.Places {
+		reqs = append(reqs, delivery.EditRequestPlacesRequest{
+			RequestID: requestID,
+			Places: delivery.Places{
+				Barcode: place.Barcode,
+				Dimensions: delivery.Dimensions{
+					WeightGross: int64(place.Weight),
+					Dx:          int64(place.Box.Dx),
+					Dy:          int64(place.Box.Dy),
+					Dz:          int64(place.Box.Dz),
+				},
+				Items: place.Items,
+			},
+		})
+	}
+
+	return reqs
+}
+
+// unit единица товара
+type unit struct {
+	item   int
+	dims   [3]float64
+	volume float64
+	weight float64
+}
+
+type bin struct {
+	box    Box
+	units  []unit
+	volume float64
+	weight float64
+}
+
+// Pack распределяет товары по коробкам.
+// Единицы товаров упаковываются по убыванию объема в первое подходящее
+// грузоместо, после чего для каждого грузоместа выбирается наименьшая
+// коробка, в которую помещается его содержимое.
+//
+// Объем товара берется из PredefinedVolume, а если он не задан,
+// вычисляется по габаритам. Товары можно поворачивать произвольно.
+// Товар с нулевым или отрицательным Count возвращает ошибку ErrNoCount
+func Pack(items []delivery.Item, opts Options) (*Result, error) {
+	if len(opts.Boxes) == 0 {
+		return nil, ErrNoBoxes
+	}
+
+	barcode := opts.Barcode
+	if barcode == nil {
+		barcode = func(i int) string { return fmt.Sprintf("place-%d", i+1) }
+	}
+
+	boxes := slices.Clone(opts.Boxes)
+	slices.SortStableFunc(boxes, func(a, b Box) int {
+		return cmp.Compare(a.volume(), b.volume())
+	})
+
+	units, err := expand(items)
+	if err != nil {
+		return nil, err
+	}
+
+	bins := []*bin{}
+	for _, u := range units {
+		i := slices.IndexFunc(bins, func(b *bin) bool { return b.fits(b.box, u) })
+		if i >= 0 {
+			bins[i].add(u)
+			continue
+		}
+
+		// новое грузоместо открывается в наибольшей коробке,
+		// ее размер уменьшается после упаковки всех товаров
+		empty := &bin{}
+		j := lastIndex(boxes, func(box Box) bool { return empty.fits(box, u) })
+		if j < 0 {
+			return nil, fmt.Errorf("%w: %v", ErrItemTooLarge, items[u.item].Name)
+		}
+
+		empty.box = boxes[j]
+		empty.add(u)
+		bins = append(bins, empty)
+	}
+
+	res := &Result{}
+	for i, b := range bins {
+		b.shrink(boxes)
+		res.add(items, b, barcode(i))
+	}
+
+	return res, nil
+}
+
+func expand(items []delivery.Item) ([]unit, error) {
+	units := []unit{}
+	for i, item := range items {
+		if item.Count <= 0 {
+			return nil, fmt.Errorf("%w: %v", ErrNoCount, item.Name)
+		}
+
+		dims := item.PhysicalDims
+		if dims == nil {
+			return nil, fmt.Errorf("%w: %v", ErrNoDimensions, item.Name)
+		}
+
+		u := unit{
+			item:   i,
+			dims:   sorted(dims.Dx, dims.Dy, dims.Dz),
+			volume: dims.PredefinedVolume,
+			weight: dims.WeightGross,
+		}
+		if u.volume == 0 {
+			u.volume = dims.Dx * dims.Dy * dims.Dz
+		}
+
+		for range item.Count {
+			units = append(units, u)
+		}
+	}
+
+	slices.SortStableFunc(units, func(a, b unit) int {
+		return cmp.Compare(b.volume, a.volume)
+	})
+
+	return units, nil
+}
+
+// fits проверяет, помещается ли единица товара в коробку box
+// вместе с уже упакованными товарами
+func (b *bin) fits(box Box, u unit) bool {
+	if b.volume+u.volume > box.volume() {
+		return false
+	}
+
+	if box.MaxWeight > 0 && box.Weight+b.weight+u.weight > box.MaxWeight {
+		return false
+	}
+
+	dims := sorted(box.Dx, box.Dy, box.Dz)
+	for i := range dims {
+		if u.dims[i] > dims[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (b *bin) add(u unit) {
+	b.units = append(b.units, u)
+	b.volume += u.volume
+	b.weight += u.weight
+}
+
+// shrink выбирает наименьшую коробку, в которую помещается содержимое
+func (b *bin) shrink(boxes []Box) {
+	for _, box := range boxes {
+		check := &bin{}
+		if !slices.ContainsFunc(b.units, func(u unit) bool {
+			ok := check.fits(box, u)
+			check.add(u)
+			return !ok
+		}) {
+			b.box = box
+			return
+		}
+	}
+}
+
+func (r *Result) add(items []delivery.Item, b *bin, barcode string) {
+	counts := map[int]int64{}
+	order := []int{}
+	for _, u := range b.units {
+		if counts[u.item] == 0 {
+			order = append(order, u.item)
+		}
+		counts[u.item]++
+	}
+	slices.Sort(order)
+
+	place := Place{
+		Barcode: barcode,
+		Box:     b.box,
+		Weight:  b.box.Weight + b.weight,
+	}
+
+	for _, i := range order {
+		item := items[i]
+		item.Count = counts[i]
+		item.PlaceBarcode = barcode
+		r.Items = append(r.Items, item)
+
+		place.Items = append(place.Items, delivery.PlacesItem{
+			Count:       counts[i],
+			ItemBarcode: item.Article,
+		})
+	}
+
+	r.Places = append(r.Places, place)
+}
+
+func sorted(a, b, c float64) [3]float64 {
+	dims := [3]float64{a, b, c}
+	slices.Sort(dims[:])
+	return dims
+}
+
+func lastIndex[T any](s []T, f func(T) bool) int {
+	for i := len(s) - 1; i >= 0; i-- {
+		if f(s[i]) {
+			return i
+		}
+	}
+	return -1
+}
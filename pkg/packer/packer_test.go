@@ -0,0 +1,139 @@
+package packer_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/delivery"
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/packer"
+	"github.com/stretchr/testify/assert"
+)
+
+var boxes = []packer.Box{
+	{Name: "L", Dx: 40, Dy: 30, Dz: 30, Weight: 300, MaxWeight: 10000},
+	{Name: "S", Dx: 20, Dy: 15, Dz: 10, Weight: 100, MaxWeight: 3000},
+}
+
+func item(article string, count int64, dx, dy, dz, weight float64) delivery.Item {
+	return delivery.Item{
+		Name:         article,
+		Article:      article,
+		Count:        count,
+		PhysicalDims: &delivery.PhysicalDims{Dx: dx, Dy: dy, Dz: dz, WeightGross: weight},
+	}
+}
+
+func TestPack(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Items  []delivery.Item
+		Boxes  []string
+		Counts [][]int64
+		Err    error
+	}{
+		{
+			Name:   "single small box",
+			Items:  []delivery.Item{item("a", 2, 10, 10, 5, 500)},
+			Boxes:  []string{"S"},
+			Counts: [][]int64{{2}},
+		},
+		{
+			Name:   "rotated item",
+			Items:  []delivery.Item{item("a", 1, 10, 18, 14, 500)},
+			Boxes:  []string{"S"},
+			Counts: [][]int64{{1}},
+		},
+		{
+			Name:   "split by weight",
+			Items:  []delivery.Item{item("a", 3, 10, 10, 10, 4000)},
+			Boxes:  []string{"L", "L"},
+			Counts: [][]int64{{2}, {1}},
+		},
+		{
+			Name:   "rest in small box",
+			Items:  []delivery.Item{item("a", 5, 10, 10, 5, 2000)},
+			Boxes:  []string{"L", "S"},
+			Counts: [][]int64{{4}, {1}},
+		},
+		{
+			Name: "large and small items",
+			Items: []delivery.Item{
+				item("small", 3, 5, 5, 5, 100),
+				item("large", 1, 35, 25, 25, 2000),
+			},
+			Boxes:  []string{"L"},
+			Counts: [][]int64{{3, 1}},
+		},
+		{
+			Name:  "too large",
+			Items: []delivery.Item{item("a", 1, 50, 10, 10, 100)},
+			Err:   packer.ErrItemTooLarge,
+		},
+		{
+			Name:  "no dimensions",
+			Items: []delivery.Item{{Name: "a", Count: 1}},
+			Err:   packer.ErrNoDimensions,
+		},
+		{
+			Name:  "no count",
+			Items: []delivery.Item{item("a", 2, 10, 10, 5, 500), item("b", 0, 10, 10, 5, 500)},
+			Err:   packer.ErrNoCount,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			res, err := packer.Pack(c.Items, packer.Options{Boxes: boxes})
+			if c.Err != nil {
+				assert.ErrorIs(t, err, c.Err)
+				return
+			}
+			if !assert.NoError(t, err) || !assert.Len(t, res.Places, len(c.Boxes)) {
+				return
+			}
+
+			for i, place := range res.Places {
+				assert.Equal(t, c.Boxes[i], place.Box.Name)
+				assert.Equal(t, fmt.Sprintf("place-%d", i+1), place.Barcode)
+
+				counts := []int64{}
+				for _, item := range place.Items {
+					counts = append(counts, item.Count)
+				}
+				assert.Equal(t, c.Counts[i], counts)
+			}
+		})
+	}
+}
+
+func TestResult(t *testing.T) {
+	res, err := packer.Pack([]delivery.Item{item("a", 3, 10, 10, 10, 4000)}, packer.Options{
+		Boxes:   boxes,
+		Barcode: func(i int) string { return fmt.Sprintf("order-%d", i) },
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	if assert.Len(t, res.Items, 2) {
+		assert.Equal(t, "order-0", res.Items[0].PlaceBarcode)
+		assert.Equal(t, int64(2), res.Items[0].Count)
+		assert.Equal(t, "order-1", res.Items[1].PlaceBarcode)
+		assert.Equal(t, int64(1), res.Items[1].Count)
+	}
+
+	places := res.DeliveryPlaces()
+	if assert.Len(t, places, 2) {
+		assert.Equal(t, "order-0", places[0].Barcode)
+		assert.Equal(t, 8300.0, places[0].PhysicalDims.WeightGross)
+		assert.Equal(t, 40.0, places[0].PhysicalDims.Dx)
+	}
+
+	reqs := res.EditRequests("req")
+	if assert.Len(t, reqs, 2) {
+		assert.Equal(t, "req", reqs[1].RequestID)
+		assert.Equal(t, "order-1", reqs[1].Places.Barcode)
+		assert.Equal(t, int64(4300), reqs[1].Places.Dimensions.WeightGross)
+		assert.Equal(t, []delivery.PlacesItem{{Count: 1, ItemBarcode: "a"}}, reqs[1].Places.Items)
+	}
+}
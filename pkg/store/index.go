@@ -0,0 +1,46 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/delivery"
+)
+
+// Index возвращает индекс заказов для идемпотентного создания
+// (delivery.IdempotencyOptions.Index) на основе хранилища
+func Index(s Store) delivery.Index {
+	return &index{store: s}
+}
+
+type index struct {
+	store Store
+}
+
+func (i *index) Lookup(operatorRequestID string) (string, bool, error) {
+	order, err := i.store.OrderByOperatorID(context.Background(), operatorRequestID)
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return "", false, nil
+	case err != nil:
+		return "", false, err
+	default:
+		return order.RequestID, true, nil
+	}
+}
+
+func (i *index) Save(operatorRequestID, requestID string) error {
+	ctx := context.Background()
+
+	_, err := i.store.Order(ctx, requestID)
+	if !errors.Is(err, ErrNotFound) {
+		return err
+	}
+
+	return i.store.SaveOrder(ctx, Order{
+		RequestID:         requestID,
+		OperatorRequestID: operatorRequestID,
+		CreatedAt:         time.Now(),
+	})
+}
@@ -0,0 +1,126 @@
+package store
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+)
+
+// NewMemory создает хранилище в памяти процесса
+func NewMemory() *Memory {
+	return &Memory{
+		orders:    make(map[string]Order),
+		statuses:  make(map[string][]Status),
+		editTasks: make(map[string]EditTask),
+	}
+}
+
+type Memory struct {
+	mutex     sync.RWMutex
+	orders    map[string]Order
+	statuses  map[string][]Status
+	editTasks map[string]EditTask
+}
+
+func (m *Memory) SaveOrder(ctx context.Context, order Order) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.orders[order.RequestID] = order
+	return nil
+}
+
+func (m *Memory) Order(ctx context.Context, requestID string) (Order, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	order, ok := m.orders[requestID]
+	if !ok {
+		return order, ErrNotFound
+	}
+	return order, nil
+}
+
+func (m *Memory) OrderByOperatorID(ctx context.Context, operatorRequestID string) (Order, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	var (
+		found Order
+		ok    bool
+	)
+	for _, order := range m.orders {
+		if order.OperatorRequestID != operatorRequestID {
+			continue
+		}
+		if !ok || order.CreatedAt.Before(found.CreatedAt) ||
+			order.CreatedAt.Equal(found.CreatedAt) && order.RequestID < found.RequestID {
+			found, ok = order, true
+		}
+	}
+
+	if !ok {
+		return found, ErrNotFound
+	}
+	return found, nil
+}
+
+func (m *Memory) AddStatus(ctx context.Context, status Status) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	history := m.statuses[status.RequestID]
+	if slices.ContainsFunc(history, func(s Status) bool {
+		return s.Status == status.Status && s.Timestamp.Equal(status.Timestamp)
+	}) {
+		return nil
+	}
+
+	history = append(history, status)
+	slices.SortStableFunc(history, func(a, b Status) int {
+		return a.Timestamp.Compare(b.Timestamp)
+	})
+	m.statuses[status.RequestID] = history
+	return nil
+}
+
+func (m *Memory) History(ctx context.Context, requestID string) ([]Status, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return slices.Clone(m.statuses[requestID]), nil
+}
+
+func (m *Memory) SaveEditTask(ctx context.Context, task EditTask) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if prev, ok := m.editTasks[task.TaskID]; ok {
+		task.CreatedAt = prev.CreatedAt
+	}
+	if task.CreatedAt.IsZero() {
+		task.CreatedAt = time.Now()
+	}
+	if task.UpdatedAt.IsZero() {
+		task.UpdatedAt = task.CreatedAt
+	}
+	m.editTasks[task.TaskID] = task
+	return nil
+}
+
+func (m *Memory) EditTasks(ctx context.Context, requestID string) ([]EditTask, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	tasks := []EditTask{}
+	for _, task := range m.editTasks {
+		if task.RequestID == requestID {
+			tasks = append(tasks, task)
+		}
+	}
+	slices.SortFunc(tasks, func(a, b EditTask) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+	return tasks, nil
+}
@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS yandex_delivery_orders (
+    request_id          TEXT PRIMARY KEY,
+    operator_request_id TEXT NOT NULL DEFAULT '',
+    offer               JSONB,
+    created_at          TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS yandex_delivery_orders_operator_request_id
+    ON yandex_delivery_orders (operator_request_id);
+
+CREATE TABLE IF NOT EXISTS yandex_delivery_statuses (
+    request_id  TEXT NOT NULL,
+    status      TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    reason      TEXT NOT NULL DEFAULT '',
+    timestamp   TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (request_id, status, timestamp)
+);
+
+CREATE TABLE IF NOT EXISTS yandex_delivery_edit_tasks (
+    task_id    TEXT PRIMARY KEY,
+    request_id TEXT NOT NULL,
+    status     TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS yandex_delivery_edit_tasks_request_id
+    ON yandex_delivery_edit_tasks (request_id);
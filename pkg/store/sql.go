@@ -0,0 +1,253 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/delivery"
+)
+
+//go:embed migrations
+var migrations embed.FS
+
+// Dialect диалект SQL базы данных
+type Dialect string
+
+const (
+	SQLite   Dialect = "sqlite"
+	Postgres Dialect = "postgres"
+)
+
+// NewSQL создает хранилище на основе базы данных.
+// Драйвер базы данных подключается вызывающей стороной.
+// Перед использованием необходимо применить миграции методом Migrate
+func NewSQL(db *sql.DB, dialect Dialect) *SQL {
+	return &SQL{db: db, dialect: dialect}
+}
+
+type SQL struct {
+	db      *sql.DB
+	dialect Dialect
+}
+
+// Migrate применяет к базе данных миграции, которые еще не были применены
+func (s *SQL) Migrate(ctx context.Context) error {
+	files, err := fs.Glob(migrations, path.Join("migrations", string(s.dialect), "*.sql"))
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("unsupported dialect: %v", s.dialect)
+	}
+	sort.Strings(files)
+
+	_, err = s.db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS yandex_delivery_migrations (version INTEGER PRIMARY KEY)`)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		version, err := strconv.Atoi(strings.SplitN(path.Base(file), "_", 2)[0])
+		if err != nil {
+			return fmt.Errorf("migration %v: %w", file, err)
+		}
+
+		err = s.migrate(ctx, file, version)
+		if err != nil {
+			return fmt.Errorf("migration %v: %w", file, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *SQL) migrate(ctx context.Context, file string, version int) error {
+	query, err := migrations.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var applied int
+	err = tx.QueryRowContext(ctx, s.rebind(`SELECT COUNT(*) FROM yandex_delivery_migrations WHERE version = ?`), version).Scan(&applied)
+	if err != nil || applied > 0 {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, string(query))
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, s.rebind(`INSERT INTO yandex_delivery_migrations (version) VALUES (?)`), version)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (s *SQL) SaveOrder(ctx context.Context, order Order) error {
+	offer := sql.NullString{}
+	if order.Offer != nil {
+		data, err := json.Marshal(order.Offer)
+		if err != nil {
+			return err
+		}
+		offer = sql.NullString{String: string(data), Valid: true}
+	}
+
+	_, err := s.db.ExecContext(ctx, s.rebind(`
+		INSERT INTO yandex_delivery_orders (request_id, operator_request_id, offer, created_at)
+		VALUES (?, ?, ?, ?)
+		ON CONFLICT (request_id) DO UPDATE SET
+			operator_request_id = excluded.operator_request_id,
+			offer = excluded.offer,
+			created_at = excluded.created_at`),
+		order.RequestID, order.OperatorRequestID, offer, order.CreatedAt.UTC())
+	return err
+}
+
+func (s *SQL) Order(ctx context.Context, requestID string) (Order, error) {
+	return s.order(ctx, `request_id = ?`, requestID)
+}
+
+func (s *SQL) OrderByOperatorID(ctx context.Context, operatorRequestID string) (Order, error) {
+	return s.order(ctx, `operator_request_id = ?`, operatorRequestID)
+}
+
+func (s *SQL) order(ctx context.Context, where string, arg string) (Order, error) {
+	order := Order{}
+	offer := sql.NullString{}
+
+	err := s.db.QueryRowContext(ctx, s.rebind(`
+		SELECT request_id, operator_request_id, offer, created_at
+		FROM yandex_delivery_orders
+		WHERE `+where+`
+		ORDER BY created_at, request_id
+		LIMIT 1`), arg).
+		Scan(&order.RequestID, &order.OperatorRequestID, &offer, &order.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return order, ErrNotFound
+	}
+	if err != nil {
+		return order, err
+	}
+
+	if offer.Valid {
+		order.Offer = &delivery.OfferItem{}
+		err = json.Unmarshal([]byte(offer.String), order.Offer)
+	}
+
+	return order, err
+}
+
+func (s *SQL) AddStatus(ctx context.Context, status Status) error {
+	_, err := s.db.ExecContext(ctx, s.rebind(`
+		INSERT INTO yandex_delivery_statuses (request_id, status, description, reason, timestamp)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT DO NOTHING`),
+		status.RequestID, status.Status, status.Description, string(status.Reason), status.Timestamp.UTC())
+	return err
+}
+
+func (s *SQL) History(ctx context.Context, requestID string) ([]Status, error) {
+	rows, err := s.db.QueryContext(ctx, s.rebind(`
+		SELECT request_id, status, description, reason, timestamp
+		FROM yandex_delivery_statuses
+		WHERE request_id = ?
+		ORDER BY timestamp`), requestID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	history := []Status{}
+	for rows.Next() {
+		status := Status{}
+		err = rows.Scan(&status.RequestID, &status.Status, &status.Description, &status.Reason, &status.Timestamp)
+		if err != nil {
+			return nil, err
+		}
+		history = append(history, status)
+	}
+
+	return history, rows.Err()
+}
+
+func (s *SQL) SaveEditTask(ctx context.Context, task EditTask) error {
+	if task.CreatedAt.IsZero() {
+		task.CreatedAt = time.Now()
+	}
+	if task.UpdatedAt.IsZero() {
+		task.UpdatedAt = task.CreatedAt
+	}
+
+	_, err := s.db.ExecContext(ctx, s.rebind(`
+		INSERT INTO yandex_delivery_edit_tasks (task_id, request_id, status, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT (task_id) DO UPDATE SET
+			status = excluded.status,
+			updated_at = excluded.updated_at`),
+		task.TaskID, task.RequestID, string(task.Status), task.CreatedAt.UTC(), task.UpdatedAt.UTC())
+	return err
+}
+
+func (s *SQL) EditTasks(ctx context.Context, requestID string) ([]EditTask, error) {
+	rows, err := s.db.QueryContext(ctx, s.rebind(`
+		SELECT task_id, request_id, status, created_at, updated_at
+		FROM yandex_delivery_edit_tasks
+		WHERE request_id = ?
+		ORDER BY created_at`), requestID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tasks := []EditTask{}
+	for rows.Next() {
+		task := EditTask{}
+		err = rows.Scan(&task.TaskID, &task.RequestID, &task.Status, &task.CreatedAt, &task.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+
+	return tasks, rows.Err()
+}
+
+// rebind заменяет плейсхолдеры "?" на "$n" для Postgres
+func (s *SQL) rebind(query string) string {
+	if s.dialect != Postgres {
+		return query
+	}
+
+	b := strings.Builder{}
+	n := 0
+	for _, r := range query {
+		if r == '?' {
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,60 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/delivery"
+)
+
+var (
+	ErrNotFound = errors.New("not found")
+)
+
+// Store хранит информацию о созданных заказах
+type Store interface {
+	// SaveOrder сохраняет заказ. Существующий заказ с тем же RequestID обновляется
+	SaveOrder(ctx context.Context, order Order) error
+	// Order возвращает заказ по идентификатору в платформе
+	Order(ctx context.Context, requestID string) (Order, error)
+	// OrderByOperatorID возвращает заказ по идентификатору заказа отправителя.
+	// Если таких заказов несколько, возвращается самый ранний по CreatedAt
+	OrderByOperatorID(ctx context.Context, operatorRequestID string) (Order, error)
+
+	// AddStatus добавляет статус в историю заказа. Повторное добавление статуса игнорируется
+	AddStatus(ctx context.Context, status Status) error
+	// History возвращает историю статусов заказа в хронологическом порядке
+	History(ctx context.Context, requestID string) ([]Status, error)
+
+	// SaveEditTask сохраняет запрос на редактирование заказа
+	SaveEditTask(ctx context.Context, task EditTask) error
+	// EditTasks возвращает запросы на редактирование заказа в порядке создания
+	EditTasks(ctx context.Context, requestID string) ([]EditTask, error)
+}
+
+// Order созданный заказ
+type Order struct {
+	RequestID         string              // Идентификатор заказа в платформе
+	OperatorRequestID string              // Идентификатор заказа у отправителя
+	Offer             *delivery.OfferItem // Подтвержденный оффер, если заказ создан через оффер
+	CreatedAt         time.Time           // Время создания заказа
+}
+
+// Status статус заказа из истории
+type Status struct {
+	RequestID   string          // Идентификатор заказа в платформе
+	Status      string          // Статус заказа
+	Description string          // Описание статуса
+	Reason      delivery.Reason // Причина изменения статуса
+	Timestamp   time.Time       // Время установки статуса
+}
+
+// EditTask запрос на редактирование заказа
+type EditTask struct {
+	TaskID    string                        // Идентификатор запроса на редактирование
+	RequestID string                        // Идентификатор заказа в платформе
+	Status    delivery.EditingRequestStatus // Статус запроса на редактирование
+	CreatedAt time.Time                     // Время создания запроса
+	UpdatedAt time.Time                     // Время обновления статуса
+}
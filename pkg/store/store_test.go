@@ -0,0 +1,186 @@
+package store_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/delivery"
+	"github.com/ReanSn0w/go-yandex-delivery/pkg/store"
+	"github.com/stretchr/testify/assert"
+
+	_ "modernc.org/sqlite"
+)
+
+func stores(t *testing.T) map[string]store.Store {
+	db, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { db.Close() })
+
+	s := store.NewSQL(db, store.SQLite)
+	if err := s.Migrate(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	// повторное применение миграций ничего не делает
+	if err := s.Migrate(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	return map[string]store.Store{
+		"memory": store.NewMemory(),
+		"sqlite": s,
+	}
+}
+
+func TestStore_Orders(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	for name, s := range stores(t) {
+		t.Run(name, func(t *testing.T) {
+			_, err := s.Order(ctx, "r1")
+			assert.ErrorIs(t, err, store.ErrNotFound)
+
+			offer := &delivery.OfferItem{OfferID: "o1", ExpiresAt: now.Add(time.Hour)}
+			offer.OfferDetails.PricingTotal = "299 RUB"
+
+			err = s.SaveOrder(ctx, store.Order{RequestID: "r1", OperatorRequestID: "op1", Offer: offer, CreatedAt: now})
+			assert.NoError(t, err)
+			err = s.SaveOrder(ctx, store.Order{RequestID: "r2", OperatorRequestID: "op2", CreatedAt: now})
+			assert.NoError(t, err)
+
+			order, err := s.OrderByOperatorID(ctx, "op1")
+			if assert.NoError(t, err) {
+				assert.Equal(t, "r1", order.RequestID)
+				assert.True(t, now.Equal(order.CreatedAt))
+				if assert.NotNil(t, order.Offer) {
+					assert.Equal(t, "o1", order.Offer.OfferID)
+					assert.Equal(t, "299 RUB", order.Offer.OfferDetails.PricingTotal)
+				}
+			}
+
+			order, err = s.Order(ctx, "r2")
+			if assert.NoError(t, err) {
+				assert.Equal(t, "op2", order.OperatorRequestID)
+				assert.Nil(t, order.Offer)
+			}
+
+			err = s.SaveOrder(ctx, store.Order{RequestID: "r2", OperatorRequestID: "op3", CreatedAt: now})
+			assert.NoError(t, err)
+			_, err = s.OrderByOperatorID(ctx, "op2")
+			assert.ErrorIs(t, err, store.ErrNotFound)
+		})
+	}
+}
+
+func TestStore_OrderByOperatorID_Duplicates(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	for name, s := range stores(t) {
+		t.Run(name, func(t *testing.T) {
+			for i, requestID := range []string{"r3", "r1", "r2"} {
+				err := s.SaveOrder(ctx, store.Order{
+					RequestID:         requestID,
+					OperatorRequestID: "op1",
+					CreatedAt:         now.Add(time.Duration(2-i) * time.Minute),
+				})
+				assert.NoError(t, err)
+			}
+
+			// Возвращается самый ранний заказ, а не последний сохраненный
+			order, err := s.OrderByOperatorID(ctx, "op1")
+			if assert.NoError(t, err) {
+				assert.Equal(t, "r2", order.RequestID)
+				assert.True(t, now.Equal(order.CreatedAt))
+			}
+		})
+	}
+}
+
+func TestStore_History(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	for name, s := range stores(t) {
+		t.Run(name, func(t *testing.T) {
+			statuses := []store.Status{
+				{RequestID: "r1", Status: "DELIVERY_PROCESSING_STARTED", Timestamp: now.Add(time.Minute)},
+				{RequestID: "r1", Status: "CREATED", Timestamp: now},
+				{RequestID: "r1", Status: "CREATED", Timestamp: now},
+				{RequestID: "r1", Status: "CANCELLED", Reason: delivery.R_Cancel_ShopCanceled, Timestamp: now.Add(time.Hour)},
+				{RequestID: "r2", Status: "CREATED", Timestamp: now},
+			}
+			for _, status := range statuses {
+				assert.NoError(t, s.AddStatus(ctx, status))
+			}
+
+			history, err := s.History(ctx, "r1")
+			if assert.NoError(t, err) && assert.Len(t, history, 3) {
+				assert.Equal(t, "CREATED", history[0].Status)
+				assert.Equal(t, "DELIVERY_PROCESSING_STARTED", history[1].Status)
+				assert.Equal(t, delivery.R_Cancel_ShopCanceled, history[2].Reason)
+			}
+
+			history, err = s.History(ctx, "unknown")
+			assert.NoError(t, err)
+			assert.Empty(t, history)
+		})
+	}
+}
+
+func TestStore_EditTasks(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	for name, s := range stores(t) {
+		t.Run(name, func(t *testing.T) {
+			assert.NoError(t, s.SaveEditTask(ctx, store.EditTask{TaskID: "t2", RequestID: "r1", Status: delivery.ERS_Pending, CreatedAt: now.Add(time.Minute)}))
+			assert.NoError(t, s.SaveEditTask(ctx, store.EditTask{TaskID: "t1", RequestID: "r1", Status: delivery.ERS_Pending, CreatedAt: now}))
+			assert.NoError(t, s.SaveEditTask(ctx, store.EditTask{TaskID: "t1", RequestID: "r1", Status: delivery.ERS_Success, CreatedAt: now.Add(time.Hour), UpdatedAt: now.Add(time.Hour)}))
+
+			tasks, err := s.EditTasks(ctx, "r1")
+			if assert.NoError(t, err) && assert.Len(t, tasks, 2) {
+				assert.Equal(t, "t1", tasks[0].TaskID)
+				assert.Equal(t, delivery.ERS_Success, tasks[0].Status)
+				assert.True(t, now.Equal(tasks[0].CreatedAt))
+				assert.True(t, now.Add(time.Hour).Equal(tasks[0].UpdatedAt))
+				assert.Equal(t, "t2", tasks[1].TaskID)
+			}
+		})
+	}
+}
+
+func TestIndex(t *testing.T) {
+	ctx := context.Background()
+
+	for name, s := range stores(t) {
+		t.Run(name, func(t *testing.T) {
+			index := store.Index(s)
+
+			_, ok, err := index.Lookup("op1")
+			assert.NoError(t, err)
+			assert.False(t, ok)
+
+			offer := &delivery.OfferItem{OfferID: "o1"}
+			assert.NoError(t, s.SaveOrder(ctx, store.Order{RequestID: "r1", OperatorRequestID: "op1", Offer: offer, CreatedAt: time.Now()}))
+
+			// существующий заказ не перезаписывается
+			assert.NoError(t, index.Save("op1", "r1"))
+			order, err := s.Order(ctx, "r1")
+			if assert.NoError(t, err) && assert.NotNil(t, order.Offer) {
+				assert.Equal(t, "o1", order.Offer.OfferID)
+			}
+
+			assert.NoError(t, index.Save("op2", "r2"))
+			requestID, ok, err := index.Lookup("op2")
+			assert.NoError(t, err)
+			assert.True(t, ok)
+			assert.Equal(t, "r2", requestID)
+		})
+	}
+}
@@ -1,7 +1,41 @@
 package utils
 
-import "github.com/ReanSn0w/gokit/pkg/web"
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/ReanSn0w/gokit/pkg/web"
+)
 
 type API interface {
 	Request(string, string) *web.JsonRequest
+	RequestContext(context.Context, string, string) *web.JsonRequest
+
+	// Download выполняет POST запрос с JSON телом body
+	// и возвращает тело ответа без разбора, например PDF файл
+	Download(ctx context.Context, base, path string, body any) (io.ReadCloser, error)
+}
+
+// ResponseObserver получает разобранные ответы API.
+// Клиент API может реализовать интерфейс, чтобы обрабатывать ответы
+// после разбора, например api.API с обработчиками WithResponseHook
+type ResponseObserver interface {
+	ObserveResponse(ctx context.Context, res any) error
+}
+
+// Limiter ограничивает частоту запросов к API.
+// Wait блокируется до момента, когда запрос разрешено выполнить,
+// или возвращает ошибку при отмене контекста
+type Limiter interface {
+	Wait(ctx context.Context) error
+}
+
+// Metrics принимает метрики выполнения запросов к API
+type Metrics interface {
+	// Client оборачивает HTTP клиент для сбора метрик запросов
+	Client(client web.HTTPClient) web.HTTPClient
+
+	// ObserveRateLimitWait учитывает время ожидания ограничителя частоты запросов
+	ObserveRateLimitWait(endpoint string, wait time.Duration)
 }
@@ -0,0 +1,20 @@
+package utils
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+)
+
+// ReadBody читает тело ответа и восстанавливает его,
+// чтобы ответ мог быть прочитан повторно
+func ReadBody(resp *http.Response) ([]byte, error) {
+	if resp == nil || resp.Body == nil {
+		return nil, nil
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+	return data, err
+}
@@ -0,0 +1,17 @@
+package utils
+
+import "context"
+
+type endpointKey struct{}
+
+// WithEndpoint сохраняет в контексте путь метода API,
+// по которому выполняется запрос
+func WithEndpoint(ctx context.Context, endpoint string) context.Context {
+	return context.WithValue(ctx, endpointKey{}, endpoint)
+}
+
+// Endpoint возвращает путь метода API из контекста запроса
+func Endpoint(ctx context.Context) string {
+	endpoint, _ := ctx.Value(endpointKey{}).(string)
+	return endpoint
+}
@@ -0,0 +1,14 @@
+package utils
+
+import "fmt"
+
+// ResponseError ответ API с кодом ошибки.
+// Тело ответа сохраняется без разбора
+type ResponseError struct {
+	StatusCode int
+	Body       []byte
+}
+
+func (e *ResponseError) Error() string {
+	return fmt.Sprintf("api response error %d: %s", e.StatusCode, e.Body)
+}